@@ -6,6 +6,8 @@ import (
 	"net/url"
 	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/neo4j/cli/common/clicfg/credentials"
 	"github.com/neo4j/cli/common/clicfg/fileutils"
@@ -22,9 +24,24 @@ const (
 	DefaultAuraBaseUrl     = "https://api.neo4j.io"
 	DefaultAuraAuthUrl     = "https://api.neo4j.io/oauth/token"
 	DefaultAuraBetaEnabled = false
+	// Maximum number of times a failed request is retried
+	DefaultAuraRetryMaxRetries = 3
+	// Maximum number of seconds to wait between retries
+	DefaultAuraRetryMaxBackoff = 30
+	// Maximum duration of a single request to the Aura API
+	DefaultAuraTimeout = 60 * time.Second
+	// Number of seconds between two status checks when awaiting an operation
+	DefaultAuraPollingInterval = 20
+	// Maximum number of status checks when awaiting an operation
+	DefaultAuraPollingMaxRetries = 60
+	// Lifetime of cached GET responses, caching is disabled when 0
+	DefaultAuraCacheTTL time.Duration = 0
 )
 
-var ValidOutputValues = [3]string{"default", "json", "table"}
+var ValidOutputValues = [7]string{"default", "json", "table", "yaml", "csv", "tsv", "template"}
+
+// Resources whose default columns in tabular output can be set with the columns.<resource> config keys
+var ColumnsConfigResources = []string{"instance", "snapshot", "customer-managed-key", "tenant", "graphql", "auth-provider", "session"}
 
 type Config struct {
 	Version     string
@@ -44,7 +61,6 @@ func NewConfig(fs afero.Fs, version string) *Config {
 	Viper.AddConfigPath(configPath)
 	Viper.SetConfigPermissions(0600)
 
-	bindEnvironmentVariables(Viper)
 	setDefaultValues(Viper)
 
 	if !fileutils.FileExists(fs, fullConfigPath) {
@@ -63,23 +79,36 @@ func NewConfig(fs afero.Fs, version string) *Config {
 
 	credentials := credentials.NewCredentials(fs, ConfigPrefix)
 
+	validConfigKeys := []string{"auth-url", "base-url", "default-tenant", "output", "beta-enabled", "retry.max-retries", "retry.max-backoff", "retry.non-idempotent", "timeout", "polling.interval", "polling.max-retries", "polling.backoff", "await-timeout", "cache.ttl"}
+	for _, resource := range ColumnsConfigResources {
+		validConfigKeys = append(validConfigKeys, fmt.Sprintf("columns.%s", resource))
+	}
+
+	bindEnvironmentVariables(Viper, validConfigKeys)
+
 	return &Config{
 		Version: version,
 		Aura: &AuraConfig{
-			fs:    fs,
-			viper: Viper, pollingOverride: PollingConfig{
-				MaxRetries: 60,
-				Interval:   20,
-			},
-			ValidConfigKeys: []string{"auth-url", "base-url", "default-tenant", "output", "beta-enabled"},
+			fs:              fs,
+			viper:           Viper,
+			ValidConfigKeys: validConfigKeys,
+			flags:           map[string]*pflag.Flag{},
 		},
 		Credentials: credentials,
 	}
 }
 
-func bindEnvironmentVariables(Viper *viper.Viper) {
-	Viper.BindEnv("aura.base-url", "AURA_BASE_URL")
-	Viper.BindEnv("aura.auth-url", "AURA_AUTH_URL")
+func bindEnvironmentVariables(Viper *viper.Viper, validConfigKeys []string) {
+	for _, key := range validConfigKeys {
+		Viper.BindEnv(fmt.Sprintf("aura.%s", key), EnvironmentVariable(key))
+	}
+	Viper.BindEnv("profile", "AURA_PROFILE")
+	Viper.BindEnv("credential", "AURA_CREDENTIAL")
+}
+
+// Name of the environment variable overriding a config key, such as AURA_POLLING_MAX_RETRIES for polling.max-retries
+func EnvironmentVariable(key string) string {
+	return "AURA_" + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
 }
 
 func setDefaultValues(Viper *viper.Viper) {
@@ -87,18 +116,43 @@ func setDefaultValues(Viper *viper.Viper) {
 	Viper.SetDefault("aura.auth-url", DefaultAuraAuthUrl)
 	Viper.SetDefault("aura.output", "default")
 	Viper.SetDefault("aura.beta-enabled", DefaultAuraBetaEnabled)
+	Viper.SetDefault("aura.retry.max-retries", DefaultAuraRetryMaxRetries)
+	Viper.SetDefault("aura.retry.max-backoff", DefaultAuraRetryMaxBackoff)
+	Viper.SetDefault("aura.retry.non-idempotent", false)
+	Viper.SetDefault("aura.timeout", DefaultAuraTimeout.String())
+	Viper.SetDefault("aura.polling.interval", DefaultAuraPollingInterval)
+	Viper.SetDefault("aura.polling.max-retries", DefaultAuraPollingMaxRetries)
+	Viper.SetDefault("aura.polling.backoff", false)
+	Viper.SetDefault("aura.await-timeout", "0s")
+	Viper.SetDefault("aura.cache.ttl", DefaultAuraCacheTTL.String())
 }
 
 type AuraConfig struct {
 	viper           *viper.Viper
 	fs              afero.Fs
-	pollingOverride PollingConfig
 	ValidConfigKeys []string
+	// Profile whose settings are applied and edited, the top-level settings are used when empty
+	profile string
+	// Flags bound to config keys, to tell where the value of a key comes from
+	flags map[string]*pflag.Flag
 }
 
 type PollingConfig struct {
+	// Seconds between two status checks
 	Interval   int
 	MaxRetries int
+	// Doubles the interval after each status check
+	Backoff bool
+	// Overall limit on the wait, no limit when 0
+	AwaitTimeout time.Duration
+}
+
+type RetryConfig struct {
+	MaxRetries int
+	// Upper bound in seconds for the wait between two attempts
+	MaxBackoff int
+	// Also replay methods that are not idempotent, such as POST and PATCH
+	NonIdempotent bool
 }
 
 func (config *AuraConfig) IsValidConfigKey(key string) bool {
@@ -110,24 +164,45 @@ func (config *AuraConfig) Get(key string) interface{} {
 }
 
 func (config *AuraConfig) Set(key string, value string) {
-	filename := config.viper.ConfigFileUsed()
-	data := fileutils.ReadFileSafe(config.fs, filename)
+	section := "aura"
+	if config.profile != "" {
+		section = fmt.Sprintf("profiles.%s.aura", config.profile)
+	}
 
-	updateConfig, err := sjson.Set(string(data), fmt.Sprintf("aura.%s", key), value)
+	err := config.updateFile(func(data string) (string, error) {
+		updateConfig, err := sjson.Set(data, fmt.Sprintf("%s.%s", section, key), value)
+		if err != nil {
+			return "", err
+		}
+
+		if key == "base-url" {
+			updatedAuraBaseUrl := config.auraBaseUrlOnConfigChange(value)
+			return sjson.Set(updateConfig, fmt.Sprintf("%s.base-url", section), updatedAuraBaseUrl)
+		}
+		return updateConfig, nil
+	})
 	if err != nil {
 		panic(err)
 	}
+}
 
-	if key == "base-url" {
-		updatedAuraBaseUrl := config.auraBaseUrlOnConfigChange(value)
-		intermediateUpdateConfig, err := sjson.Set(string(updateConfig), "aura.base-url", updatedAuraBaseUrl)
-		if err != nil {
-			panic(err)
-		}
-		updateConfig = intermediateUpdateConfig
+// Changes the config file under lock, starting from its current content so changes made by other invocations are kept
+func (config *AuraConfig) updateFile(update func(data string) (string, error)) error {
+	filename := config.viper.ConfigFileUsed()
+
+	unlock, err := fileutils.Lock(config.fs, filename)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	data, err := update(string(fileutils.ReadFileSafe(config.fs, filename)))
+	if err != nil {
+		return err
 	}
 
-	fileutils.WriteFile(config.fs, filename, []byte(updateConfig))
+	fileutils.WriteFile(config.fs, filename, []byte(data))
+	return nil
 }
 
 func (config *AuraConfig) Print(cmd *cobra.Command) {
@@ -163,9 +238,7 @@ func (config *AuraConfig) BetaPathV2() string {
 }
 
 func (config *AuraConfig) BindBaseUrl(flag *pflag.Flag) {
-	if err := config.viper.BindPFlag("aura.base-url", flag); err != nil {
-		panic(err)
-	}
+	config.bindFlag("base-url", flag)
 }
 
 func (config *AuraConfig) AuthUrl() string {
@@ -173,9 +246,7 @@ func (config *AuraConfig) AuthUrl() string {
 }
 
 func (config *AuraConfig) BindAuthUrl(flag *pflag.Flag) {
-	if err := config.viper.BindPFlag("aura.auth-url", flag); err != nil {
-		panic(err)
-	}
+	config.bindFlag("auth-url", flag)
 }
 
 func (config *AuraConfig) Output() string {
@@ -183,9 +254,23 @@ func (config *AuraConfig) Output() string {
 }
 
 func (config *AuraConfig) BindOutput(flag *pflag.Flag) {
-	if err := config.viper.BindPFlag("aura.output", flag); err != nil {
-		panic(err)
+	config.bindFlag("output", flag)
+}
+
+// Returns the default columns configured for a resource, nil when not configured
+func (config *AuraConfig) Columns(resource string) []string {
+	value := config.viper.GetString(fmt.Sprintf("aura.columns.%s", resource))
+	if value == "" {
+		return nil
+	}
+
+	columns := []string{}
+	for _, column := range strings.Split(value, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
 	}
+	return columns
 }
 
 func (config *AuraConfig) AuraBetaEnabled() bool {
@@ -201,14 +286,75 @@ func (config *AuraConfig) Fs() afero.Fs {
 }
 
 func (config *AuraConfig) PollingConfig() PollingConfig {
-	return config.pollingOverride
+	return PollingConfig{
+		Interval:     config.viper.GetInt("aura.polling.interval"),
+		MaxRetries:   config.viper.GetInt("aura.polling.max-retries"),
+		Backoff:      config.viper.GetBool("aura.polling.backoff"),
+		AwaitTimeout: config.viper.GetDuration("aura.await-timeout"),
+	}
+}
+
+func (config *AuraConfig) BindPollingInterval(flag *pflag.Flag) {
+	config.bindFlag("polling.interval", flag)
+}
+
+func (config *AuraConfig) BindPollingMaxRetries(flag *pflag.Flag) {
+	config.bindFlag("polling.max-retries", flag)
+}
+
+func (config *AuraConfig) BindPollingBackoff(flag *pflag.Flag) {
+	config.bindFlag("polling.backoff", flag)
+}
+
+func (config *AuraConfig) BindAwaitTimeout(flag *pflag.Flag) {
+	config.bindFlag("await-timeout", flag)
+}
+
+func (config *AuraConfig) RetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:    config.viper.GetInt("aura.retry.max-retries"),
+		MaxBackoff:    config.viper.GetInt("aura.retry.max-backoff"),
+		NonIdempotent: config.viper.GetBool("aura.retry.non-idempotent"),
+	}
 }
 
-func (config *AuraConfig) SetPollingConfig(maxRetries int, interval int) {
-	config.pollingOverride = PollingConfig{
-		MaxRetries: maxRetries,
-		Interval:   interval,
+func (config *AuraConfig) BindRetryMaxRetries(flag *pflag.Flag) {
+	config.bindFlag("retry.max-retries", flag)
+}
+
+func (config *AuraConfig) BindRetryMaxBackoff(flag *pflag.Flag) {
+	config.bindFlag("retry.max-backoff", flag)
+}
+
+func (config *AuraConfig) BindRetryNonIdempotent(flag *pflag.Flag) {
+	config.bindFlag("retry.non-idempotent", flag)
+}
+
+func (config *AuraConfig) Timeout() time.Duration {
+	return config.viper.GetDuration("aura.timeout")
+}
+
+func (config *AuraConfig) BindTimeout(flag *pflag.Flag) {
+	config.bindFlag("timeout", flag)
+}
+
+// Returns how long GET responses are cached, 0 when caching is disabled by the config or for this invocation
+func (config *AuraConfig) CacheTTL() time.Duration {
+	if config.viper.GetBool("aura.no-cache") {
+		return 0
+	}
+	return config.viper.GetDuration("aura.cache.ttl")
+}
+
+func (config *AuraConfig) BindNoCache(flag *pflag.Flag) {
+	config.bindFlag("no-cache", flag)
+}
+
+func (config *AuraConfig) bindFlag(key string, flag *pflag.Flag) {
+	if err := config.viper.BindPFlag(fmt.Sprintf("aura.%s", key), flag); err != nil {
+		panic(err)
 	}
+	config.flags[key] = flag
 }
 
 func (config *AuraConfig) auraBaseUrlOnConfigChange(url string) string {
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/test/utils/testfs"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"sync"
 	"testing"
 )
 
@@ -40,3 +44,93 @@ func TestGetAuraBaseUrlConfigRemovesTrailingPath(t *testing.T) {
 	//The path parameter will be removed from GET base url
 	assert.Equal(t, server.URL, cfg.Aura.BaseUrl())
 }
+
+func TestSelectProfile(t *testing.T) {
+	cfgStr := `{
+		"aura": {
+			"base-url": "https://top-level.example.com",
+			"output": "json"
+		},
+		"profiles": {
+			"staging": {
+				"aura": {
+					"base-url": "https://staging.example.com"
+				},
+				"credential": "staging-cred"
+			}
+		},
+		"profile": "staging"
+	}`
+
+	credentialsStr := `{
+		"aura": {
+			"credentials": [{"name": "test-cred"}, {"name": "staging-cred"}],
+			"default-credential": "test-cred"
+		}
+	}`
+
+	fs, err := testfs.GetTestFs(cfgStr, credentialsStr)
+	assert.Nil(t, err)
+	cfg := clicfg.NewConfig(fs, "test")
+
+	assert.Nil(t, cfg.SelectProfile())
+
+	assert.Equal(t, "staging", cfg.Profile())
+	assert.Equal(t, "https://staging.example.com", cfg.Aura.BaseUrl())
+	assert.Equal(t, "json", cfg.Aura.Output())
+
+	credential, err := cfg.Credentials.Aura.GetDefault()
+	assert.Nil(t, err)
+	assert.Equal(t, "staging-cred", credential.Name)
+	// Selecting the credential of a profile does not change the default one
+	assert.Equal(t, "test-cred", cfg.Credentials.Aura.DefaultCredential)
+}
+
+func TestCredentialsFromEnvironmentDoNotCreateFile(t *testing.T) {
+	t.Setenv("AURA_CLIENT_ID", "env-client-id")
+	t.Setenv("AURA_CLIENT_SECRET", "env-client-secret")
+
+	fs := afero.NewMemMapFs()
+	cfg := clicfg.NewConfig(fs, "test")
+
+	credential, err := cfg.Credentials.Aura.GetDefault()
+	assert.Nil(t, err)
+	assert.Equal(t, "env-client-id", credential.ClientId)
+
+	_, err = cfg.Credentials.Aura.UpdateAccessToken(credential, "token", 3600)
+	assert.Nil(t, err)
+
+	exists, err := afero.Exists(fs, filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "credentials.json"))
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func TestConcurrentSetsAreAllKept(t *testing.T) {
+	const invocations = 20
+
+	configPrefix := clicfg.ConfigPrefix
+	clicfg.ConfigPrefix = t.TempDir()
+	t.Cleanup(func() { clicfg.ConfigPrefix = configPrefix })
+
+	fs := afero.NewOsFs()
+	configs := make([]*clicfg.Config, invocations)
+	for i := range configs {
+		configs[i] = clicfg.NewConfig(fs, "test")
+	}
+
+	var wg sync.WaitGroup
+	for i, cfg := range configs {
+		wg.Add(1)
+		go func(i int, cfg *clicfg.Config) {
+			defer wg.Done()
+			cfg.Aura.Set(fmt.Sprintf("key-%d", i), fmt.Sprintf("value-%d", i))
+		}(i, cfg)
+	}
+	wg.Wait()
+
+	data, err := afero.ReadFile(fs, filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "config.json"))
+	assert.Nil(t, err)
+	for i := 0; i < invocations; i++ {
+		assert.Equal(t, fmt.Sprintf("value-%d", i), gjson.GetBytes(data, fmt.Sprintf("aura.key-%d", i)).String())
+	}
+}
@@ -0,0 +1,33 @@
+package clicfg
+
+import (
+	"fmt"
+
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/spf13/pflag"
+	"github.com/tidwall/gjson"
+)
+
+// Selects the credential of this invocation from the --credential flag, falling back to the AURA_CREDENTIAL environment variable,
+// without changing the default credential shared by every other invocation
+func (config *Config) BindCredential(flag *pflag.Flag) {
+	if err := config.Aura.viper.BindPFlag("credential", flag); err != nil {
+		panic(err)
+	}
+}
+
+// Selects the credential given by the flag or environment variable, or else the one of the profile in use, or else the default one.
+// A credential given by environment variables replaces the one of the profile, but not one named for this invocation,
+// as running against another account than the one asked for is worse than failing.
+func (config *Config) SelectCredential() error {
+	credential := config.Aura.viper.GetString("credential")
+	if credential != "" && config.Credentials.Aura.FromEnvironment() {
+		return clierr.NewUsageError("cannot use credential %s as credentials are also given by the %s, %s or %s environment variables, unset either of them", credential, credentials.ClientIdEnvironmentVariable, credentials.ClientSecretEnvironmentVariable, credentials.AccessTokenEnvironmentVariable)
+	}
+	if credential == "" && config.Aura.profile != "" {
+		credential = gjson.Get(config.readFile(), fmt.Sprintf("profiles.%s.credential", config.Aura.profile)).String()
+	}
+
+	return config.Credentials.Aura.Select(credential)
+}
@@ -11,7 +11,14 @@ import (
 type AuraCredentials struct {
 	DefaultCredential string            `json:"default-credential"`
 	Credentials       []*AuraCredential `json:"credentials"`
-	onUpdate          func()
+	// Credential used instead of the default one for this invocation only
+	selected string
+	// Only holds the credential given by environment variables, which cannot be changed
+	fromEnvironment bool
+	// Stores the secrets of the credentials when they are not kept in the credentials file
+	secrets SecretBackend
+	// Applies a change to the stored credentials, which other invocations may have changed since they were loaded
+	onUpdate func(change func(stored *AuraCredentials) error) error
 }
 
 func (c *AuraCredentials) List() []*AuraCredential {
@@ -30,59 +37,129 @@ func (config *AuraCredentials) Print(writer io.Writer) error {
 }
 
 func (c *AuraCredentials) Add(name string, clientId string, clientSecret string) error {
-	auraCredentials := c.Credentials
-	for _, credential := range auraCredentials {
-		if credential.Name == name {
+	if err := c.checkNotFromEnvironment(); err != nil {
+		return err
+	}
+
+	change := func(credentials *AuraCredentials) error {
+		if credentials.credentialExists(name) {
 			return clierr.NewUsageError("already have credential with name %s", name)
 		}
+
+		credentials.Credentials = append(credentials.Credentials, &AuraCredential{Name: name, ClientId: clientId, ClientSecret: clientSecret, secretsLoaded: true})
+		if len(credentials.Credentials) == 1 {
+			credentials.DefaultCredential = name
+		}
+		return nil
+	}
+	if err := change(c); err != nil {
+		return err
 	}
 
-	c.Credentials = append(c.Credentials, &AuraCredential{Name: name, ClientId: clientId, ClientSecret: clientSecret})
-	if len(c.Credentials) == 1 {
-		c.SetDefault(name)
+	// The secret is only saved once the name is known to be free in the stored credentials, so another credential
+	// added with the same name concurrently keeps its secret
+	if err := c.onUpdate(change); err != nil {
+		return err
 	}
-	c.onUpdate()
-	return nil
+	return c.secrets.Save(name, Secrets{ClientSecret: clientSecret})
 }
 
 func (c *AuraCredentials) Remove(name string) error {
-	var indexToRemove = -1
+	if err := c.checkNotFromEnvironment(); err != nil {
+		return err
+	}
 
-	for i, credential := range c.Credentials {
-		if credential.Name == name {
-			indexToRemove = i
-			break
+	change := func(credentials *AuraCredentials) error {
+		var indexToRemove = -1
+
+		for i, credential := range credentials.Credentials {
+			if credential.Name == name {
+				indexToRemove = i
+				break
+			}
+		}
+
+		if indexToRemove == -1 {
+			return clierr.NewUsageError("could not find credential with name %s to remove", name)
+		}
+
+		if credentials.DefaultCredential == name {
+			credentials.DefaultCredential = ""
 		}
+
+		credentials.Credentials = append(credentials.Credentials[:indexToRemove], credentials.Credentials[indexToRemove+1:]...)
+		return nil
+	}
+	if err := change(c); err != nil {
+		return err
 	}
 
-	if indexToRemove == -1 {
-		return clierr.NewUsageError("could not find credential with name %s to remove", name)
+	// The secret is only deleted once the credential is removed from the stored credentials, so a failed update
+	// does not leave a stored credential without its secret
+	if err := c.onUpdate(change); err != nil {
+		return err
 	}
+	return c.secrets.Delete(name)
+}
 
-	if c.DefaultCredential == name {
-		c.DefaultCredential = ""
+func (c *AuraCredentials) SetDefault(name string) error {
+	if err := c.checkNotFromEnvironment(); err != nil {
+		return err
 	}
 
-	c.Credentials = append(c.Credentials[:indexToRemove], c.Credentials[indexToRemove+1:]...)
-	c.onUpdate()
-	return nil
+	change := func(credentials *AuraCredentials) error {
+		if !credentials.credentialExists(name) {
+			return clierr.NewUsageError("could not find credential with name %s", name)
+		}
+
+		credentials.DefaultCredential = name
+		return nil
+	}
+	if err := change(c); err != nil {
+		return err
+	}
+
+	return c.onUpdate(change)
 }
 
-func (c *AuraCredentials) SetDefault(name string) error {
-	if !c.credentialExists(name) {
+// Uses the credential with this name instead of the default one, without saving it. An empty name restores the default one.
+func (c *AuraCredentials) Select(name string) error {
+	// The credential given by environment variables takes precedence over the ones named by profiles
+	if c.fromEnvironment {
+		return nil
+	}
+	if name != "" && !c.credentialExists(name) {
 		return clierr.NewUsageError("could not find credential with name %s", name)
 	}
 
-	c.DefaultCredential = name
-	c.onUpdate()
+	c.selected = name
 	return nil
 }
 
 func (c *AuraCredentials) GetDefault() (*AuraCredential, error) {
-	if c.DefaultCredential == "" {
+	if c.fromEnvironment {
+		credential := c.Credentials[0]
+		if credential.AccessToken == "" && (credential.ClientId == "" || credential.ClientSecret == "") {
+			return nil, clierr.NewUsageError("both %s and %s must be set, or else %s", ClientIdEnvironmentVariable, ClientSecretEnvironmentVariable, AccessTokenEnvironmentVariable)
+		}
+		return credential, nil
+	}
+	name := c.selected
+	if name == "" {
+		name = c.DefaultCredential
+	}
+	if name == "" {
 		return nil, clierr.NewUsageError("default credential not set, please follow the instructions at https://neo4j.com/docs/aura/classic/platform/api/authentication/#_creating_credentials and use the `credential add` subcommand to add the created credentials")
 	}
-	return c.Get(c.DefaultCredential)
+
+	credential, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.loadSecrets(credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
 }
 
 func (c *AuraCredentials) Get(name string) (*AuraCredential, error) {
@@ -94,33 +171,85 @@ func (c *AuraCredentials) Get(name string) (*AuraCredential, error) {
 	return nil, clierr.NewUsageError("could not find credential with name %s", name)
 }
 
-func (c *AuraCredentials) UpdateAccessToken(cred *AuraCredential, accessToken string, expiresInSeconds int64) *AuraCredential {
-	credential, err := c.Get(cred.Name)
-	if err != nil {
-		panic(err)
-	}
+func (c *AuraCredentials) UpdateAccessToken(cred *AuraCredential, accessToken string, expiresInSeconds int64) (*AuraCredential, error) {
 	const expireToleranceSeconds = 60
 
 	now := time.Now().UnixMilli()
+	tokenExpiry := now + (expiresInSeconds-expireToleranceSeconds)*1000
 
-	credential.TokenExpiry = now + (expiresInSeconds-expireToleranceSeconds)*1000
-	credential.AccessToken = accessToken
-	c.onUpdate()
-	return credential
+	return c.updateToken(cred.Name, accessToken, tokenExpiry)
 }
 
 func (c *AuraCredentials) ClearAccessToken(cred *AuraCredential) (*AuraCredential, error) {
-	credential, err := c.Get(cred.Name)
-	if err != nil {
+	return c.updateToken(cred.Name, "", 0)
+}
+
+// Only the token of this credential is changed in the stored credentials, so concurrent refreshes of other credentials are kept
+func (c *AuraCredentials) updateToken(name string, accessToken string, tokenExpiry int64) (*AuraCredential, error) {
+	change := func(credentials *AuraCredentials) error {
+		credential, err := credentials.Get(name)
+		if err != nil {
+			return err
+		}
+
+		credential.TokenExpiry = tokenExpiry
+		credential.AccessToken = accessToken
+		return nil
+	}
+	if err := change(c); err != nil {
 		return nil, err
 	}
 
-	credential.TokenExpiry = 0
-	credential.AccessToken = ""
-	c.onUpdate()
+	credential, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.saveSecrets(credential); err != nil {
+		return nil, err
+	}
+	if err := c.onUpdate(change); err != nil {
+		return nil, err
+	}
 	return credential, nil
 }
 
+// Secrets are only read from the backend when a credential is used, as it may ask for a passphrase or unlock a keyring
+func (c *AuraCredentials) loadSecrets(credential *AuraCredential) error {
+	if credential.secretsLoaded {
+		return nil
+	}
+
+	secrets, err := c.secrets.Load(credential.Name)
+	if err != nil {
+		return err
+	}
+
+	credential.ClientSecret = secrets.ClientSecret
+	credential.AccessToken = secrets.AccessToken
+	credential.secretsLoaded = true
+	return nil
+}
+
+// Saving secrets that were never loaded would overwrite the stored ones with empty values
+func (c *AuraCredentials) saveSecrets(credential *AuraCredential) error {
+	if !credential.secretsLoaded {
+		return clierr.NewFatalError("secrets of credential %s saved before being loaded", credential.Name)
+	}
+	return c.secrets.Save(credential.Name, credential.secrets())
+}
+
+// Tells whether the credential is given by environment variables rather than the credentials file
+func (c *AuraCredentials) FromEnvironment() bool {
+	return c.fromEnvironment
+}
+
+func (c *AuraCredentials) checkNotFromEnvironment() error {
+	if c.fromEnvironment {
+		return clierr.NewUsageError("credentials are given by the %s, %s or %s environment variables, unset them to manage the stored credentials", ClientIdEnvironmentVariable, ClientSecretEnvironmentVariable, AccessTokenEnvironmentVariable)
+	}
+	return nil
+}
+
 func (c *AuraCredentials) credentialExists(name string) bool {
 	for _, credential := range c.Credentials {
 		if credential.Name == name {
@@ -136,6 +265,12 @@ type AuraCredential struct {
 	ClientSecret string `json:"client-secret"`
 	AccessToken  string `json:"access-token"`
 	TokenExpiry  int64  `json:"token-expiry"`
+	// ClientSecret and AccessToken hold the values of the secret backend
+	secretsLoaded bool
+}
+
+func (credential *AuraCredential) secrets() Secrets {
+	return Secrets{ClientSecret: credential.ClientSecret, AccessToken: credential.AccessToken}
 }
 
 func (credential *AuraCredential) HasValidAccessToken() bool {
@@ -2,14 +2,27 @@ package credentials
 
 import (
 	"encoding/json"
+	"math"
+	"os"
 	"path/filepath"
 
 	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/spf13/afero"
 )
 
+const (
+	ClientIdEnvironmentVariable     = "AURA_CLIENT_ID"
+	ClientSecretEnvironmentVariable = "AURA_CLIENT_SECRET"
+	AccessTokenEnvironmentVariable  = "AURA_ACCESS_TOKEN"
+	// Name of the credential given by environment variables
+	EnvironmentCredentialName = "environment"
+)
+
 type CredentialsFile struct {
 	Aura *AuraCredentials `json:"aura"`
+	// Where the client secrets and access tokens are stored, in this file when empty
+	SecretBackend string `json:"secret-backend,omitempty"`
 }
 
 type Credentials struct {
@@ -24,18 +37,71 @@ func NewCredentials(fs afero.Fs, configPrefix string) *Credentials {
 		fs:       fs,
 		filePath: configPath,
 	}
-	c.load()
+	if !c.loadFromEnvironment() {
+		c.load()
+	}
 	return &c
 }
 
+// Uses the credential given by environment variables when any is set, such as on ephemeral CI runners.
+// The credentials file is then neither read nor written, and the access token obtained lives as long as the process.
+func (c *Credentials) loadFromEnvironment() bool {
+	clientId := os.Getenv(ClientIdEnvironmentVariable)
+	clientSecret := os.Getenv(ClientSecretEnvironmentVariable)
+	accessToken := os.Getenv(AccessTokenEnvironmentVariable)
+	if clientId == "" && clientSecret == "" && accessToken == "" {
+		return false
+	}
+
+	credential := &AuraCredential{
+		Name:          EnvironmentCredentialName,
+		ClientId:      clientId,
+		ClientSecret:  clientSecret,
+		AccessToken:   accessToken,
+		secretsLoaded: true,
+	}
+	if accessToken != "" {
+		// The expiry of a token minted elsewhere is unknown, the API rejects it once expired
+		credential.TokenExpiry = math.MaxInt64
+	}
+
+	c.Aura = &AuraCredentials{
+		DefaultCredential: EnvironmentCredentialName,
+		Credentials:       []*AuraCredential{credential},
+		fromEnvironment:   true,
+		secrets:           plaintextBackend{},
+		onUpdate:          func(change func(stored *AuraCredentials) error) error { return nil },
+	}
+	return true
+}
+
 func (c *Credentials) load() {
+	credentials, fileHasData := c.read()
+
+	c.Aura = credentials.Aura
+	c.Aura.onUpdate = c.update
+	c.Aura.secrets = c.newSecretBackend(credentials.SecretBackend)
+	if c.Aura.secrets.Name() == SecretBackendPlaintext {
+		for _, credential := range c.Aura.Credentials {
+			credential.secretsLoaded = true
+		}
+	}
+
+	if !fileHasData {
+		if err := c.save(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// The file is always replaced as a whole, so it can be read without holding the lock
+func (c *Credentials) read() (CredentialsFile, bool) {
 	data := fileutils.ReadFileSafe(c.fs, c.filePath)
 	fileHasData := len(data) != 0
 
 	var credentials CredentialsFile = CredentialsFile{
 		Aura: &AuraCredentials{
 			Credentials: []*AuraCredential{},
-			onUpdate:    c.save,
 		},
 	}
 	if fileHasData {
@@ -43,21 +109,138 @@ func (c *Credentials) load() {
 			panic(err)
 		}
 	}
+	return credentials, fileHasData
+}
 
-	c.Aura = credentials.Aura
+// A backend that cannot be used, such as the Secret Service without secret-tool, only fails once secrets are needed,
+// so commands that do not need them keep working
+func (c *Credentials) newSecretBackend(name string) SecretBackend {
+	backend, err := NewSecretBackend(c.fs, filepath.Dir(c.filePath), name)
+	if err != nil {
+		return unavailableBackend{name: name, err: err}
+	}
+	return backend
+}
 
-	if !fileHasData {
-		c.save()
+// Writes the credentials held in memory as a whole, replacing whatever the file holds
+func (c *Credentials) save() error {
+	unlock, err := fileutils.Lock(c.fs, c.filePath)
+	if err != nil {
+		return err
 	}
+	defer unlock()
+
+	c.write(c.Aura)
+	return nil
 }
 
-func (c *Credentials) save() {
-	data, err := json.Marshal(CredentialsFile{
-		Aura: c.Aura,
-	})
+// Applies a change to the credentials currently in the file rather than writing the ones held in memory,
+// so changes made by other invocations since the file was loaded are not lost
+func (c *Credentials) update(change func(stored *AuraCredentials) error) error {
+	unlock, err := fileutils.Lock(c.fs, c.filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	stored, _ := c.read()
+	if err := change(stored.Aura); err != nil {
+		return err
+	}
+
+	c.write(stored.Aura)
+	return nil
+}
+
+func (c *Credentials) write(credentials *AuraCredentials) {
+	file := CredentialsFile{
+		Aura: credentials,
+	}
+
+	if backend := c.Aura.secrets.Name(); backend != SecretBackendPlaintext {
+		file.SecretBackend = backend
+
+		// Only the secrets are kept out of the file
+		withoutSecrets := *credentials
+		withoutSecrets.Credentials = []*AuraCredential{}
+		for _, credential := range credentials.Credentials {
+			stripped := *credential
+			stripped.ClientSecret = ""
+			stripped.AccessToken = ""
+			withoutSecrets.Credentials = append(withoutSecrets.Credentials, &stripped)
+		}
+		file.Aura = &withoutSecrets
+	}
+
+	data, err := json.Marshal(file)
 	if err != nil {
 		panic(err)
 	}
 
 	fileutils.WriteFile(c.fs, c.filePath, data)
 }
+
+// Moves the secrets of every credential to another backend, removing them from the current one once the move succeeded
+func (c *Credentials) MigrateSecrets(backendName string) error {
+	if c.Aura.fromEnvironment {
+		return c.Aura.checkNotFromEnvironment()
+	}
+
+	current := c.Aura.secrets
+	if current.Name() == backendName {
+		return clierr.NewUsageError("secrets are already stored in the %s backend", backendName)
+	}
+
+	target, err := NewSecretBackend(c.fs, filepath.Dir(c.filePath), backendName)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range c.Aura.Credentials {
+		if err := c.Aura.loadSecrets(credential); err != nil {
+			return err
+		}
+		if err := target.Save(credential.Name, credential.secrets()); err != nil {
+			return err
+		}
+	}
+
+	c.Aura.secrets = target
+	if err := c.save(); err != nil {
+		return err
+	}
+
+	for _, credential := range c.Aura.Credentials {
+		if err := current.Delete(credential.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The backend the secrets are stored in
+func (c *Credentials) SecretBackend() string {
+	return c.Aura.secrets.Name()
+}
+
+type unavailableBackend struct {
+	name string
+	err  error
+}
+
+func (b unavailableBackend) Name() string {
+	return b.name
+}
+
+func (b unavailableBackend) Load(credential string) (Secrets, error) {
+	return Secrets{}, b.err
+}
+
+func (b unavailableBackend) Save(credential string, secrets Secrets) error {
+	return b.err
+}
+
+func (b unavailableBackend) Delete(credential string) error {
+	return b.err
+}
@@ -0,0 +1,122 @@
+package credentials_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+// Every invocation loads the credentials before any of them refreshes its token, as separate processes would,
+// so a write from the stale state of one would drop the tokens saved by the others
+func TestConcurrentTokenRefreshesAreAllKept(t *testing.T) {
+	const invocations = 20
+
+	fs := afero.NewOsFs()
+	configPrefix := t.TempDir()
+	filePath := filepath.Join(configPrefix, "neo4j", "cli", "credentials.json")
+	assert.Nil(t, fs.MkdirAll(filepath.Dir(filePath), 0755))
+
+	initial := credentials.NewCredentials(fs, configPrefix)
+	for i := 0; i < invocations; i++ {
+		assert.Nil(t, initial.Aura.Add(fmt.Sprintf("cred-%d", i), fmt.Sprintf("client-id-%d", i), "client-secret"))
+	}
+
+	loaded := make([]*credentials.Credentials, invocations)
+	for i := range loaded {
+		loaded[i] = credentials.NewCredentials(fs, configPrefix)
+	}
+
+	var wg sync.WaitGroup
+	for i, c := range loaded {
+		wg.Add(1)
+		go func(i int, c *credentials.Credentials) {
+			defer wg.Done()
+
+			credential, err := c.Aura.Get(fmt.Sprintf("cred-%d", i))
+			assert.Nil(t, err)
+			_, err = c.Aura.UpdateAccessToken(credential, fmt.Sprintf("token-%d", i), 3600)
+			assert.Nil(t, err)
+		}(i, c)
+	}
+	wg.Wait()
+
+	data, err := os.ReadFile(filePath)
+	assert.Nil(t, err)
+	assert.True(t, json.Valid(data))
+
+	final := credentials.NewCredentials(fs, configPrefix)
+	assert.Len(t, final.Aura.Credentials, invocations)
+	assert.Equal(t, "cred-0", final.Aura.DefaultCredential)
+	for i := 0; i < invocations; i++ {
+		credential, err := final.Aura.Get(fmt.Sprintf("cred-%d", i))
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("token-%d", i), credential.AccessToken)
+		assert.Equal(t, "client-secret", credential.ClientSecret)
+	}
+
+	temporaryFiles, err := filepath.Glob(filePath + ".*.tmp")
+	assert.Nil(t, err)
+	assert.Empty(t, temporaryFiles)
+}
+
+func TestConcurrentAddWithSameNameKeepsExistingSecret(t *testing.T) {
+	t.Setenv(credentials.PassphraseEnvironmentVariable, "passphrase")
+
+	fs := afero.NewMemMapFs()
+	assert.Nil(t, fs.MkdirAll(filepath.Join("/config", "neo4j", "cli"), 0755))
+	initial := credentials.NewCredentials(fs, "/config")
+	assert.Nil(t, initial.MigrateSecrets(credentials.SecretBackendEncryptedFile))
+
+	first := credentials.NewCredentials(fs, "/config")
+	second := credentials.NewCredentials(fs, "/config")
+
+	assert.Nil(t, first.Aura.Add("test", "first-client-id", "first-secret"))
+	assert.EqualError(t, second.Aura.Add("test", "second-client-id", "second-secret"), "already have credential with name test")
+
+	final := credentials.NewCredentials(fs, "/config")
+	credential, err := final.Aura.GetDefault()
+	assert.Nil(t, err)
+	assert.Equal(t, "first-client-id", credential.ClientId)
+	assert.Equal(t, "first-secret", credential.ClientSecret)
+}
+
+// Fails to lock the credentials file, as when another invocation holds the lock for too long
+type lockFailingFs struct {
+	afero.Fs
+	failLock bool
+}
+
+func (fs *lockFailingFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if fs.failLock && strings.HasSuffix(name, "credentials.json.lock") {
+		return nil, os.ErrPermission
+	}
+	return fs.Fs.OpenFile(name, flag, perm)
+}
+
+func TestRemoveKeepsSecretWhenUpdateFails(t *testing.T) {
+	t.Setenv(credentials.PassphraseEnvironmentVariable, "passphrase")
+
+	fs := &lockFailingFs{Fs: afero.NewMemMapFs()}
+	assert.Nil(t, fs.MkdirAll(filepath.Join("/config", "neo4j", "cli"), 0755))
+	initial := credentials.NewCredentials(fs, "/config")
+	assert.Nil(t, initial.MigrateSecrets(credentials.SecretBackendEncryptedFile))
+	assert.Nil(t, initial.Aura.Add("test", "client-id", "secret"))
+
+	fs.failLock = true
+	assert.ErrorIs(t, credentials.NewCredentials(fs, "/config").Aura.Remove("test"), os.ErrPermission)
+	fs.failLock = false
+
+	final := credentials.NewCredentials(fs, "/config")
+	credential, err := final.Aura.GetDefault()
+	assert.Nil(t, err)
+	assert.Equal(t, "client-id", credential.ClientId)
+	assert.Equal(t, "secret", credential.ClientSecret)
+}
@@ -0,0 +1,190 @@
+package credentials
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/spf13/afero"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const PassphraseEnvironmentVariable = "AURA_CREDENTIALS_PASSPHRASE"
+
+const (
+	encryptedFileVersion = 1
+	// Number of PBKDF2 iterations recommended by OWASP for HMAC-SHA256
+	encryptedFileIterations = 600000
+	encryptedFileSaltSize   = 16
+	encryptedFileKeySize    = 32
+)
+
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Keeps the secrets of every credential in one file, encrypted with AES-256-GCM using a key derived from a passphrase
+type encryptedFileBackend struct {
+	fs   afero.Fs
+	path string
+	// Deriving the key is slow on purpose, so it is only done once per salt
+	salt []byte
+	key  []byte
+}
+
+func newEncryptedFileBackend(fs afero.Fs, path string) *encryptedFileBackend {
+	return &encryptedFileBackend{fs: fs, path: path}
+}
+
+func (b *encryptedFileBackend) Name() string {
+	return SecretBackendEncryptedFile
+}
+
+func (b *encryptedFileBackend) Load(credential string) (Secrets, error) {
+	all, err := b.read()
+	if err != nil {
+		return Secrets{}, err
+	}
+
+	secrets, ok := all[credential]
+	if !ok {
+		return Secrets{}, clierr.NewUsageError("could not find the secrets of credential %s in %s", credential, b.path)
+	}
+	return secrets, nil
+}
+
+func (b *encryptedFileBackend) Save(credential string, secrets Secrets) error {
+	unlock, err := fileutils.Lock(b.fs, b.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	all, err := b.read()
+	if err != nil {
+		return err
+	}
+
+	all[credential] = secrets
+	return b.write(all)
+}
+
+func (b *encryptedFileBackend) Delete(credential string) error {
+	unlock, err := fileutils.Lock(b.fs, b.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	all, err := b.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := all[credential]; !ok {
+		return nil
+	}
+	delete(all, credential)
+	return b.write(all)
+}
+
+// A missing file holds no secrets yet
+func (b *encryptedFileBackend) read() (map[string]Secrets, error) {
+	all := map[string]Secrets{}
+
+	data := fileutils.ReadFileSafe(b.fs, b.path)
+	if len(data) == 0 {
+		return all, nil
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, clierr.NewFatalError("cannot read %s: %w", b.path, err)
+	}
+	if file.Version != encryptedFileVersion {
+		return nil, clierr.NewFatalError("cannot read %s: unsupported version %d", b.path, file.Version)
+	}
+
+	gcm, err := b.cipher(file.Salt, file.Iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, clierr.NewUsageError("cannot decrypt %s, check the passphrase set in %s", b.path, PassphraseEnvironmentVariable)
+	}
+
+	if err := json.Unmarshal(plaintext, &all); err != nil {
+		return nil, clierr.NewFatalError("cannot read %s: %w", b.path, err)
+	}
+	return all, nil
+}
+
+// Encrypts with a new nonce on every write, keeping the salt so the key does not need to be derived again
+func (b *encryptedFileBackend) write(all map[string]Secrets) error {
+	salt := b.salt
+	if salt == nil {
+		salt = make([]byte, encryptedFileSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return clierr.NewFatalError("cannot generate salt: %w", err)
+		}
+	}
+
+	gcm, err := b.cipher(salt, encryptedFileIterations)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return clierr.NewFatalError("cannot generate nonce: %w", err)
+	}
+
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return clierr.NewFatalError("cannot encode secrets: %w", err)
+	}
+
+	data, err := json.Marshal(encryptedFile{
+		Version:    encryptedFileVersion,
+		Iterations: encryptedFileIterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return clierr.NewFatalError("cannot encode %s: %w", b.path, err)
+	}
+
+	fileutils.WriteFile(b.fs, b.path, data)
+	return nil
+}
+
+func (b *encryptedFileBackend) cipher(salt []byte, iterations int) (cipher.AEAD, error) {
+	if b.key == nil || !bytes.Equal(b.salt, salt) {
+		passphrase := os.Getenv(PassphraseEnvironmentVariable)
+		if passphrase == "" {
+			return nil, clierr.NewUsageError("the %s backend requires a passphrase, set it in %s", SecretBackendEncryptedFile, PassphraseEnvironmentVariable)
+		}
+
+		b.salt = salt
+		b.key = pbkdf2.Key([]byte(passphrase), salt, iterations, encryptedFileKeySize, sha256.New)
+	}
+
+	block, err := aes.NewCipher(b.key)
+	if err != nil {
+		return nil, clierr.NewFatalError("cannot create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,55 @@
+package credentials
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptedFileBackend(t *testing.T) {
+	t.Setenv(PassphraseEnvironmentVariable, "passphrase")
+	fs := afero.NewMemMapFs()
+
+	backend := newEncryptedFileBackend(fs, "/credentials.secrets.json")
+	assert.Nil(t, backend.Save("test", Secrets{ClientSecret: "secret", AccessToken: "token"}))
+	assert.Nil(t, backend.Save("other", Secrets{ClientSecret: "other-secret"}))
+
+	data, err := afero.ReadFile(fs, "/credentials.secrets.json")
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.NotContains(t, string(data), "token")
+
+	// A new backend derives the key again from the passphrase and the salt of the file
+	backend = newEncryptedFileBackend(fs, "/credentials.secrets.json")
+	secrets, err := backend.Load("test")
+	assert.Nil(t, err)
+	assert.Equal(t, Secrets{ClientSecret: "secret", AccessToken: "token"}, secrets)
+
+	assert.Nil(t, backend.Delete("test"))
+
+	_, err = backend.Load("test")
+	assert.EqualError(t, err, "could not find the secrets of credential test in /credentials.secrets.json")
+	secrets, err = backend.Load("other")
+	assert.Nil(t, err)
+	assert.Equal(t, Secrets{ClientSecret: "other-secret"}, secrets)
+}
+
+func TestEncryptedFileBackendWithWrongPassphrase(t *testing.T) {
+	t.Setenv(PassphraseEnvironmentVariable, "passphrase")
+	fs := afero.NewMemMapFs()
+	assert.Nil(t, newEncryptedFileBackend(fs, "/credentials.secrets.json").Save("test", Secrets{ClientSecret: "secret"}))
+
+	t.Setenv(PassphraseEnvironmentVariable, "wrong")
+	_, err := newEncryptedFileBackend(fs, "/credentials.secrets.json").Load("test")
+
+	assert.EqualError(t, err, "cannot decrypt /credentials.secrets.json, check the passphrase set in AURA_CREDENTIALS_PASSPHRASE")
+}
+
+func TestEncryptedFileBackendWithoutPassphrase(t *testing.T) {
+	t.Setenv(PassphraseEnvironmentVariable, "")
+
+	err := newEncryptedFileBackend(afero.NewMemMapFs(), "/credentials.secrets.json").Save("test", Secrets{ClientSecret: "secret"})
+
+	assert.EqualError(t, err, "the encrypted-file backend requires a passphrase, set it in AURA_CREDENTIALS_PASSPHRASE")
+}
@@ -0,0 +1,73 @@
+package credentials
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/spf13/afero"
+)
+
+// Backends the client secrets and access tokens can be stored in
+const (
+	// Secrets are kept in the credentials file itself, as before backends existed
+	SecretBackendPlaintext = "plaintext"
+	// Secrets are kept in the freedesktop Secret Service, such as GNOME Keyring or KWallet
+	SecretBackendSecretService = "secret-service"
+	// Secrets are kept in a file encrypted with a passphrase, which works without a desktop session
+	SecretBackendEncryptedFile = "encrypted-file"
+)
+
+var SecretBackends = []string{SecretBackendPlaintext, SecretBackendSecretService, SecretBackendEncryptedFile}
+
+// The parts of a credential that must not be stored in plaintext
+type Secrets struct {
+	ClientSecret string `json:"client-secret"`
+	AccessToken  string `json:"access-token"`
+}
+
+// Stores the secrets of each credential, by credential name
+type SecretBackend interface {
+	Name() string
+	Load(credential string) (Secrets, error)
+	Save(credential string, secrets Secrets) error
+	Delete(credential string) error
+}
+
+// Returns the backend with this name, storing its files if any in dir
+func NewSecretBackend(fs afero.Fs, dir string, name string) (SecretBackend, error) {
+	switch name {
+	case "", SecretBackendPlaintext:
+		return plaintextBackend{}, nil
+	case SecretBackendSecretService:
+		return newSecretServiceBackend()
+	case SecretBackendEncryptedFile:
+		return newEncryptedFileBackend(fs, filepath.Join(dir, "credentials.secrets.json")), nil
+	default:
+		return nil, clierr.NewUsageError("invalid secret backend %s, must be one of %s", name, strings.Join(SecretBackends, ", "))
+	}
+}
+
+func IsValidSecretBackend(name string) bool {
+	return slices.Contains(SecretBackends, name)
+}
+
+// The secrets are saved along with the rest of the credential in the credentials file, so there is nothing else to do
+type plaintextBackend struct{}
+
+func (plaintextBackend) Name() string {
+	return SecretBackendPlaintext
+}
+
+func (plaintextBackend) Load(credential string) (Secrets, error) {
+	return Secrets{}, nil
+}
+
+func (plaintextBackend) Save(credential string, secrets Secrets) error {
+	return nil
+}
+
+func (plaintextBackend) Delete(credential string) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+//go:build linux
+
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Attribute identifying the items of the CLI in the Secret Service
+const secretServiceService = "neo4j-aura-cli"
+
+// Talks to the Secret Service through secret-tool from libsecret, which is installed along with the keyrings implementing it
+type secretServiceBackend struct {
+	command string
+}
+
+func newSecretServiceBackend() (SecretBackend, error) {
+	command, err := exec.LookPath("secret-tool")
+	if err != nil {
+		return nil, clierr.NewUsageError("the %s backend requires the secret-tool command, usually provided by the libsecret-tools or libsecret package", SecretBackendSecretService)
+	}
+	return &secretServiceBackend{command: command}, nil
+}
+
+func (b *secretServiceBackend) Name() string {
+	return SecretBackendSecretService
+}
+
+func (b *secretServiceBackend) Load(credential string) (Secrets, error) {
+	out, err := b.run(nil, "lookup", "service", secretServiceService, "credential", credential)
+	if err != nil {
+		return Secrets{}, err
+	}
+	// secret-tool prints nothing, and fails without a message, when no item matches
+	if len(out) == 0 {
+		return Secrets{}, clierr.NewUsageError("could not find the secrets of credential %s in the Secret Service", credential)
+	}
+
+	var secrets Secrets
+	if err := json.Unmarshal(out, &secrets); err != nil {
+		return Secrets{}, clierr.NewFatalError("cannot read the secrets of credential %s from the Secret Service: %w", credential, err)
+	}
+	return secrets, nil
+}
+
+func (b *secretServiceBackend) Save(credential string, secrets Secrets) error {
+	data, err := json.Marshal(secrets)
+	if err != nil {
+		return clierr.NewFatalError("cannot encode the secrets of credential %s: %w", credential, err)
+	}
+
+	label := fmt.Sprintf("Neo4j Aura CLI credential %s", credential)
+	_, err = b.run(data, "store", "--label", label, "service", secretServiceService, "credential", credential)
+	return err
+}
+
+func (b *secretServiceBackend) Delete(credential string) error {
+	_, err := b.run(nil, "clear", "service", secretServiceService, "credential", credential)
+	return err
+}
+
+// Passes secrets on stdin, so they never show in the arguments of a process
+func (b *secretServiceBackend) run(stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command(b.command, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && stderr.Len() == 0 {
+			// Only lookup fails without a message to tell that no item matches, store and clear must not be taken as done
+			if args[0] == "lookup" {
+				return nil, nil
+			}
+			return nil, clierr.NewUsageError("secret-tool %s failed with exit code %d", args[0], exitErr.ExitCode())
+		}
+		return nil, clierr.NewUsageError("secret-tool %s failed: %s", args[0], strings.TrimSpace(stderr.String()))
+	}
+	return bytes.TrimSpace(out), nil
+}
@@ -0,0 +1,84 @@
+//go:build linux
+
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+// Stands in for secret-tool, keeping each item in a file named after the credential attribute
+const fakeSecretTool = `#!/bin/sh
+command=$1
+shift
+while [ $# -gt 0 ]; do
+	case $1 in
+		--label) shift 2 ;;
+		credential) credential=$2; shift 2 ;;
+		*) shift 2 ;;
+	esac
+done
+case $command in
+	store) [ -z "$FAIL_STORE" ] || exit 1; cat > "$SECRETS_DIR/$credential" ;;
+	lookup) [ -f "$SECRETS_DIR/$credential" ] || exit 1; cat "$SECRETS_DIR/$credential" ;;
+	clear) rm -f "$SECRETS_DIR/$credential" ;;
+esac
+`
+
+func installFakeSecretTool(t *testing.T) {
+	binDir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(binDir, "secret-tool"), []byte(fakeSecretTool), 0700))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("SECRETS_DIR", t.TempDir())
+}
+
+func TestSecretServiceBackend(t *testing.T) {
+	installFakeSecretTool(t)
+
+	backend, err := newSecretServiceBackend()
+	assert.Nil(t, err)
+
+	assert.Nil(t, backend.Save("test", Secrets{ClientSecret: "secret", AccessToken: "token"}))
+
+	secrets, err := backend.Load("test")
+	assert.Nil(t, err)
+	assert.Equal(t, Secrets{ClientSecret: "secret", AccessToken: "token"}, secrets)
+
+	assert.Nil(t, backend.Delete("test"))
+
+	_, err = backend.Load("test")
+	assert.EqualError(t, err, "could not find the secrets of credential test in the Secret Service")
+}
+
+func TestSecretServiceBackendWithoutSecretTool(t *testing.T) {
+	// An empty directory hides any secret-tool installed
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := newSecretServiceBackend()
+
+	assert.EqualError(t, err, "the secret-service backend requires the secret-tool command, usually provided by the libsecret-tools or libsecret package")
+}
+
+func TestMigrateToSecretServiceAbortsWhenStoreFails(t *testing.T) {
+	installFakeSecretTool(t)
+	t.Setenv("FAIL_STORE", "1")
+
+	fs := afero.NewMemMapFs()
+	filePath := filepath.Join("/config", "neo4j", "cli", "credentials.json")
+	original := `{"aura":{"credentials":[{"name":"test","client-id":"id","client-secret":"secret","access-token":"token","token-expiry":123}],"default-credential":"test"}}`
+	assert.Nil(t, fs.MkdirAll(filepath.Dir(filePath), 0755))
+	assert.Nil(t, afero.WriteFile(fs, filePath, []byte(original), 0600))
+
+	credentials := NewCredentials(fs, "/config")
+	err := credentials.MigrateSecrets(SecretBackendSecretService)
+
+	assert.EqualError(t, err, "secret-tool store failed with exit code 1")
+	assert.Equal(t, SecretBackendPlaintext, credentials.SecretBackend())
+	data, err := afero.ReadFile(fs, filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, original, string(data))
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package credentials
+
+import "github.com/neo4j/cli/common/clierr"
+
+func newSecretServiceBackend() (SecretBackend, error) {
+	return nil, clierr.NewUsageError("the %s backend is only available on Linux", SecretBackendSecretService)
+}
@@ -38,11 +38,34 @@ func ReadOrCreateFile(fs afero.Fs, path string) []byte {
 	}
 }
 
+/* Replaces the content of a file atomically, by writing a temporary file next to it then renaming it, so readers never see a partial file */
 func WriteFile(fs afero.Fs, path string, data []byte) {
-	err := afero.WriteFile(fs, path, data, 0600)
+	temp, err := afero.TempFile(fs, filepath.Dir(path), filepath.Base(path)+".*.tmp")
 	if err != nil {
 		panic(err)
 	}
+
+	if err := writeAndClose(temp, data); err != nil {
+		fs.Remove(temp.Name())
+		panic(err)
+	}
+
+	if err := fs.Rename(temp.Name(), path); err != nil {
+		fs.Remove(temp.Name())
+		panic(err)
+	}
+}
+
+func writeAndClose(file afero.File, data []byte) error {
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
 }
 
 func FileExists(fs afero.Fs, path string) bool {
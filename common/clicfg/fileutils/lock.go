@@ -0,0 +1,42 @@
+package fileutils
+
+import (
+	"os"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+// Locks of the files that are not on the OS file system, such as in tests, which only need to exclude other goroutines
+var memLocks sync.Map
+
+// Takes an advisory lock on the file at path, which other invocations of the CLI wait for before changing the file.
+// The lock is held on a separate file next to it, as the file itself is replaced when written.
+func Lock(fs afero.Fs, path string) (unlock func(), err error) {
+	lockPath := path + ".lock"
+
+	file, err := fs.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	osFile, ok := file.(*os.File)
+	if !ok {
+		file.Close()
+
+		value, _ := memLocks.LoadOrStore(lockPath, &sync.Mutex{})
+		mu := value.(*sync.Mutex)
+		mu.Lock()
+		return mu.Unlock, nil
+	}
+
+	if err := lockFile(osFile); err != nil {
+		osFile.Close()
+		return nil, err
+	}
+
+	return func() {
+		unlockFile(osFile)
+		osFile.Close()
+	}, nil
+}
@@ -0,0 +1,66 @@
+package clicfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/tidwall/gjson"
+)
+
+// Where the effective value of a config key comes from, in order of precedence
+const (
+	OriginFlag    = "flag"
+	OriginEnv     = "env"
+	OriginProfile = "profile"
+	OriginFile    = "file"
+	OriginDefault = "default"
+)
+
+type ConfigValue struct {
+	Key    string      `json:"key"`
+	Value  interface{} `json:"value"`
+	Origin string      `json:"origin"`
+}
+
+// Tells where the effective value of a config key comes from, following the precedence applied by viper
+func (config *AuraConfig) Origin(key string) string {
+	if flag, ok := config.flags[key]; ok && flag.Changed {
+		return OriginFlag
+	}
+	if os.Getenv(EnvironmentVariable(key)) != "" {
+		return OriginEnv
+	}
+
+	data := string(fileutils.ReadFileSafe(config.fs, config.viper.ConfigFileUsed()))
+	if config.profile != "" && gjson.Get(data, fmt.Sprintf("profiles.%s.aura.%s", config.profile, key)).Exists() {
+		return OriginProfile
+	}
+	if gjson.Get(data, fmt.Sprintf("aura.%s", key)).Exists() {
+		return OriginFile
+	}
+
+	return OriginDefault
+}
+
+// Lists the effective value of each config key that has one, along with its origin
+func (config *AuraConfig) Values() []ConfigValue {
+	values := []ConfigValue{}
+	for _, key := range config.ValidConfigKeys {
+		value := config.Get(key)
+		if value == nil {
+			continue
+		}
+		values = append(values, ConfigValue{Key: key, Value: value, Origin: config.Origin(key)})
+	}
+	return values
+}
+
+func (config *AuraConfig) PrintWithOrigin(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(config.Values())
+}
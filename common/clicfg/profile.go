@@ -0,0 +1,182 @@
+package clicfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/spf13/pflag"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Name of the profile made of the top-level aura settings of the config file, used when no other profile is selected
+const DefaultProfile = "default"
+
+// Config keys are case insensitive, so profile names are kept lowercase to be stored as keys
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+type Profile struct {
+	Name       string `json:"name"`
+	Credential string `json:"credential"`
+	Current    bool   `json:"current"`
+}
+
+// Selects the profile of this invocation from the --profile flag, falling back to the AURA_PROFILE environment variable
+// and then to the profile set with `config profile use`
+func (config *Config) BindProfile(flag *pflag.Flag) {
+	if err := config.Aura.viper.BindPFlag("profile", flag); err != nil {
+		panic(err)
+	}
+}
+
+// Name of the profile in use
+func (config *Config) Profile() string {
+	if config.Aura.profile == "" {
+		return DefaultProfile
+	}
+	return config.Aura.profile
+}
+
+// Applies the settings and credential of the selected profile on top of the top-level aura settings.
+// Flags and environment variables still take precedence over the settings and credential of the profile.
+func (config *Config) SelectProfile() error {
+	name := config.Aura.viper.GetString("profile")
+	if name == "" {
+		name = DefaultProfile
+	}
+	if name == config.Profile() {
+		return config.SelectCredential()
+	}
+
+	var profile gjson.Result
+	if name != DefaultProfile {
+		if profile = config.readProfile(name); !profile.Exists() {
+			return clierr.NewUsageError("could not find profile with name %s", name)
+		}
+	}
+
+	// Starts over from the file, in case another profile was applied before
+	if err := config.Aura.viper.ReadInConfig(); err != nil {
+		return err
+	}
+	if settings, ok := profile.Get("aura").Value().(map[string]interface{}); ok {
+		if err := config.Aura.viper.MergeConfigMap(map[string]interface{}{"aura": settings}); err != nil {
+			return err
+		}
+	}
+
+	if name == DefaultProfile {
+		config.Aura.profile = ""
+	} else {
+		config.Aura.profile = name
+	}
+
+	return config.SelectCredential()
+}
+
+func (config *Config) CreateProfile(name string, credential string) error {
+	if name == DefaultProfile {
+		return clierr.NewUsageError("profile name %s is reserved for the top-level settings", DefaultProfile)
+	}
+	if !profileNamePattern.MatchString(name) {
+		return clierr.NewUsageError("invalid profile name %s, must only contain lowercase letters, digits, dashes and underscores", name)
+	}
+	if config.readProfile(name).Exists() {
+		return clierr.NewUsageError("already have profile with name %s", name)
+	}
+	if credential != "" {
+		if _, err := config.Credentials.Aura.Get(credential); err != nil {
+			return err
+		}
+	}
+
+	return config.updateFile(func(data string) (string, error) {
+		return sjson.Set(data, fmt.Sprintf("profiles.%s", name), map[string]interface{}{
+			"aura":       map[string]interface{}{},
+			"credential": credential,
+		})
+	})
+}
+
+// Sets the profile used when neither the --profile flag nor the AURA_PROFILE environment variable are set
+func (config *Config) UseProfile(name string) error {
+	if name == DefaultProfile {
+		return config.updateFile(func(data string) (string, error) {
+			return sjson.Delete(data, "profile")
+		})
+	}
+	if !config.readProfile(name).Exists() {
+		return clierr.NewUsageError("could not find profile with name %s", name)
+	}
+
+	return config.updateFile(func(data string) (string, error) {
+		return sjson.Set(data, "profile", name)
+	})
+}
+
+func (config *Config) DeleteProfile(name string) error {
+	if name == DefaultProfile {
+		return clierr.NewUsageError("cannot delete the %s profile", DefaultProfile)
+	}
+	if !config.readProfile(name).Exists() {
+		return clierr.NewUsageError("could not find profile with name %s to delete", name)
+	}
+
+	return config.updateFile(func(data string) (string, error) {
+		data, err := sjson.Delete(data, fmt.Sprintf("profiles.%s", name))
+		if err != nil {
+			return "", err
+		}
+		if gjson.Get(data, "profile").String() == name {
+			return sjson.Delete(data, "profile")
+		}
+		return data, nil
+	})
+}
+
+// Lists the default profile followed by the profiles of the config file, the one in use being marked as current
+func (config *Config) Profiles() []Profile {
+	profiles := []Profile{{
+		Name:       DefaultProfile,
+		Credential: config.Credentials.Aura.DefaultCredential,
+		Current:    config.Profile() == DefaultProfile,
+	}}
+
+	gjson.Get(config.readFile(), "profiles").ForEach(func(key, value gjson.Result) bool {
+		profiles = append(profiles, Profile{
+			Name:       key.String(),
+			Credential: value.Get("credential").String(),
+			Current:    config.Profile() == key.String(),
+		})
+		return true
+	})
+
+	return profiles
+}
+
+func (config *Config) PrintProfiles(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(config.Profiles())
+}
+
+func (config *Config) readFile() string {
+	return string(fileutils.ReadFileSafe(config.Aura.fs, config.Aura.viper.ConfigFileUsed()))
+}
+
+// Names that are not valid cannot have been created, which also keeps gjson from interpreting them as a path
+func (config *Config) readProfile(name string) gjson.Result {
+	if !profileNamePattern.MatchString(name) {
+		return gjson.Result{}
+	}
+	return gjson.Get(config.readFile(), fmt.Sprintf("profiles.%s", name))
+}
+
+func (config *Config) updateFile(update func(data string) (string, error)) error {
+	return config.Aura.updateFile(update)
+}
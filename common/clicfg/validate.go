@@ -0,0 +1,60 @@
+package clicfg
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/neo4j/cli/common/clierr"
+)
+
+var nonNegativeIntegerConfigKeys = []string{"retry.max-retries", "retry.max-backoff", "polling.interval", "polling.max-retries"}
+
+var durationConfigKeys = []string{"timeout", "await-timeout", "cache.ttl"}
+
+var booleanConfigKeys = []string{"beta-enabled", "retry.non-idempotent", "polling.backoff"}
+
+// Checks a value given for a config key, whether it is set in the config file or by an environment variable
+func (config *AuraConfig) ValidateValue(key string, value string) error {
+	if key == "output" && !slices.Contains(ValidOutputValues[:], value) {
+		return clierr.NewUsageError("invalid output value specified: %s", value)
+	}
+
+	if slices.Contains(nonNegativeIntegerConfigKeys, key) {
+		if parsed, err := strconv.Atoi(value); err != nil || parsed < 0 {
+			return clierr.NewUsageError("invalid value specified for %s: %s, must be a non-negative integer", key, value)
+		}
+	}
+
+	if slices.Contains(durationConfigKeys, key) {
+		if parsed, err := time.ParseDuration(value); err != nil || parsed < 0 {
+			return clierr.NewUsageError("invalid value specified for %s: %s, must be a non-negative duration such as 30s or 2m", key, value)
+		}
+	}
+
+	if slices.Contains(booleanConfigKeys, key) {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return clierr.NewUsageError("invalid value specified for %s: %s, must be true or false", key, value)
+		}
+	}
+
+	return nil
+}
+
+// Checks the values of the environment variables overriding config keys, which would otherwise be silently read as zero values
+func (config *AuraConfig) ValidateEnvironment() error {
+	for _, key := range config.ValidConfigKeys {
+		name := EnvironmentVariable(key)
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		if err := config.ValidateValue(key, value); err != nil {
+			return clierr.NewUsageError("invalid environment variable %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -1,18 +1,128 @@
 package clierr
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+type Kind int
+
+const (
+	// Usage Error, require feedback
+	KindUsage Kind = iota
+	// API errors, retry may solve it
+	KindUpstream
+	// Fatal error, unrecoverable
+	KindFatal
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUsage:
+		return "usage"
+	case KindUpstream:
+		return "upstream"
+	default:
+		return "fatal"
+	}
+}
+
+// A single error reported by the Aura API
+type Detail struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason,omitempty"`
+	Field   string `json:"field,omitempty"`
+}
+
+type Error struct {
+	Kind Kind
+	// HTTP status code of the failed request, 0 when the error did not come from a response
+	StatusCode int
+	// Correlation ID of the failed request, empty when the server did not send one
+	RequestId string
+	Details   []Detail
+	// Set when an operation did not complete in the allowed time
+	Timeout bool
+	// Set when the user cancelled the operation
+	Interrupted bool
+	err         error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return errors.Unwrap(e.err)
+}
+
+func (e *Error) WithStatusCode(statusCode int) *Error {
+	e.StatusCode = statusCode
+	return e
+}
+
+func (e *Error) WithRequestId(requestId string) *Error {
+	e.RequestId = requestId
+	return e
+}
+
+func (e *Error) WithDetails(details ...Detail) *Error {
+	e.Details = append(e.Details, details...)
+	return e
+}
 
 // Usage Error, require feedback
-func NewUsageError(msg string, a ...any) error {
-	return fmt.Errorf(msg, a...)
+func NewUsageError(msg string, a ...any) *Error {
+	return newError(KindUsage, msg, a...)
 }
 
 // API errors, retry may solve it
-func NewUpstreamError(msg string, a ...any) error {
-	return fmt.Errorf(msg, a...)
+func NewUpstreamError(msg string, a ...any) *Error {
+	return newError(KindUpstream, msg, a...)
+}
+
+// API operation that did not complete in time, retry may solve it
+func NewTimeoutError(msg string, a ...any) *Error {
+	e := newError(KindUpstream, msg, a...)
+	e.Timeout = true
+	return e
+}
+
+// Operation cancelled by the user before it completed
+func NewInterruptedError(msg string, a ...any) *Error {
+	e := newError(KindUsage, msg, a...)
+	e.Interrupted = true
+	return e
 }
 
 // Fatal error, unrecoverable
-func NewFatalError(msg string, a ...any) error {
-	return fmt.Errorf(msg, a...)
+func NewFatalError(msg string, a ...any) *Error {
+	return newError(KindFatal, msg, a...)
+}
+
+func newError(kind Kind, msg string, a ...any) *Error {
+	e := &Error{
+		Kind: kind,
+		err:  fmt.Errorf(msg, a...),
+	}
+
+	// Keep the response information of a wrapped error, so it is not lost when adding context
+	if wrapped, ok := AsError(errors.Unwrap(e.err)); ok {
+		e.StatusCode = wrapped.StatusCode
+		e.RequestId = wrapped.RequestId
+		e.Details = wrapped.Details
+		e.Timeout = wrapped.Timeout
+		e.Interrupted = wrapped.Interrupted
+	}
+
+	return e
+}
+
+// Returns the first *Error in the chain of err, if any
+func AsError(err error) (*Error, bool) {
+	var cliErr *Error
+	if errors.As(err, &cliErr) {
+		return cliErr, true
+	}
+	return nil, false
 }
@@ -0,0 +1,37 @@
+package clierr_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorKinds(t *testing.T) {
+	assert.Equal(t, clierr.KindUsage, clierr.NewUsageError("usage").Kind)
+	assert.Equal(t, clierr.KindUpstream, clierr.NewUpstreamError("upstream").Kind)
+	assert.Equal(t, clierr.KindFatal, clierr.NewFatalError("fatal").Kind)
+}
+
+func TestWrappedErrorKeepsResponseInformation(t *testing.T) {
+	responseErr := clierr.NewUpstreamError("[DB not found]").WithStatusCode(http.StatusNotFound).WithRequestId("abc").WithDetails(clierr.Detail{Message: "DB not found", Reason: "db-not-found"})
+
+	err := clierr.NewUpstreamError("error polling: %w", responseErr)
+
+	assert.Equal(t, "error polling: [DB not found]", err.Error())
+	assert.Equal(t, http.StatusNotFound, err.StatusCode)
+	assert.Equal(t, "abc", err.RequestId)
+	assert.Equal(t, []clierr.Detail{{Message: "DB not found", Reason: "db-not-found"}}, err.Details)
+	assert.ErrorIs(t, err, responseErr)
+}
+
+func TestAsError(t *testing.T) {
+	cliErr, ok := clierr.AsError(fmt.Errorf("context: %w", clierr.NewFatalError("fatal")))
+	assert.True(t, ok)
+	assert.Equal(t, clierr.KindFatal, cliErr.Kind)
+
+	_, ok = clierr.AsError(fmt.Errorf("plain"))
+	assert.False(t, ok)
+}
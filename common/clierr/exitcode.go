@@ -0,0 +1,93 @@
+package clierr
+
+import "net/http"
+
+// Process exit codes, scripts rely on them so existing values must never change
+const (
+	ExitCodeOk = 0
+	// Unexpected failure, please report an issue
+	ExitCodeFatal = 1
+	// Invalid command, arguments, flags or configuration
+	ExitCodeUsage = 2
+	// Credentials are invalid or not allowed to perform the operation
+	ExitCodeAuth = 3
+	// The resource does not exist
+	ExitCodeNotFound = 4
+	// The resource is undergoing another operation
+	ExitCodeConflict = 5
+	// Server rate limit exceeded
+	ExitCodeRateLimited = 6
+	// Operation did not complete in the allowed time
+	ExitCodeTimeout = 7
+	// Any other Aura API or network failure
+	ExitCodeUpstream = 8
+	// Cancelled with Ctrl-C, following the 128 + SIGINT shell convention
+	ExitCodeInterrupted = 130
+)
+
+// Maps the error returned by a command to the process exit code
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitCodeOk
+	}
+
+	cliErr, ok := AsError(err)
+	if !ok {
+		// Errors not raised through clierr come from flag, argument and pre-run validation
+		return ExitCodeUsage
+	}
+
+	if cliErr.Interrupted {
+		return ExitCodeInterrupted
+	}
+
+	if cliErr.Timeout {
+		return ExitCodeTimeout
+	}
+
+	switch cliErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ExitCodeAuth
+	case http.StatusNotFound:
+		return ExitCodeNotFound
+	case http.StatusConflict:
+		return ExitCodeConflict
+	case http.StatusTooManyRequests:
+		return ExitCodeRateLimited
+	}
+
+	switch cliErr.Kind {
+	case KindUsage:
+		return ExitCodeUsage
+	case KindUpstream:
+		return ExitCodeUpstream
+	default:
+		return ExitCodeFatal
+	}
+}
+
+// Stable, machine readable name of an exit code
+func ExitCodeName(exitCode int) string {
+	switch exitCode {
+	case ExitCodeOk:
+		return "ok"
+	case ExitCodeUsage:
+		return "usage"
+	case ExitCodeAuth:
+		return "auth"
+	case ExitCodeNotFound:
+		return "not-found"
+	case ExitCodeConflict:
+		return "conflict"
+	case ExitCodeRateLimited:
+		return "rate-limited"
+	case ExitCodeTimeout:
+		return "timeout"
+	case ExitCodeUpstream:
+		return "upstream"
+	case ExitCodeInterrupted:
+		return "interrupted"
+	default:
+		return "fatal"
+	}
+}
@@ -0,0 +1,48 @@
+package clierr_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"no error", nil, clierr.ExitCodeOk},
+		{"unclassified error", errors.New(`required flag(s) "name" not set`), clierr.ExitCodeUsage},
+		{"usage error", clierr.NewUsageError("invalid output value specified: invalid"), clierr.ExitCodeUsage},
+		{"fatal error", clierr.NewFatalError("unexpected error"), clierr.ExitCodeFatal},
+		{"upstream error", clierr.NewUpstreamError("[Bad request]").WithStatusCode(http.StatusBadRequest), clierr.ExitCodeUpstream},
+		{"server error", clierr.NewUpstreamError("[Internal server error]").WithStatusCode(http.StatusInternalServerError), clierr.ExitCodeUpstream},
+		{"unauthorized", clierr.NewUsageError("[Unauthorized]").WithStatusCode(http.StatusUnauthorized), clierr.ExitCodeAuth},
+		{"forbidden", clierr.NewUpstreamError("Forbidden").WithStatusCode(http.StatusForbidden), clierr.ExitCodeAuth},
+		{"not found", clierr.NewUpstreamError("[DB not found]").WithStatusCode(http.StatusNotFound), clierr.ExitCodeNotFound},
+		{"conflict", clierr.NewUpstreamError("[Ongoing operation]").WithStatusCode(http.StatusConflict), clierr.ExitCodeConflict},
+		{"rate limited", clierr.NewUpstreamError("rate limit exceeded").WithStatusCode(http.StatusTooManyRequests), clierr.ExitCodeRateLimited},
+		{"timeout", clierr.NewTimeoutError("hit max retries [60] polling"), clierr.ExitCodeTimeout},
+		{"interrupted", clierr.NewInterruptedError("interrupted while waiting for instance"), clierr.ExitCodeInterrupted},
+		{"wrapped interrupted", fmt.Errorf("context: %w", clierr.NewInterruptedError("interrupted")), clierr.ExitCodeInterrupted},
+		{"wrapped", fmt.Errorf("context: %w", clierr.NewUpstreamError("[DB not found]").WithStatusCode(http.StatusNotFound)), clierr.ExitCodeNotFound},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, clierr.ExitCode(testCase.err))
+		})
+	}
+}
+
+func TestExitCodeName(t *testing.T) {
+	assert.Equal(t, "ok", clierr.ExitCodeName(clierr.ExitCodeOk))
+	assert.Equal(t, "not-found", clierr.ExitCodeName(clierr.ExitCodeNotFound))
+	assert.Equal(t, "rate-limited", clierr.ExitCodeName(clierr.ExitCodeRateLimited))
+	assert.Equal(t, "fatal", clierr.ExitCodeName(clierr.ExitCodeFatal))
+}
@@ -1,10 +1,19 @@
 package aura
 
 import (
+	"context"
+	"os"
+	"os/signal"
+
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/graphanalytics"
 	"github.com/spf13/cobra"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/cache"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/config"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/credential"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/customermanagedkey"
@@ -18,17 +27,114 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 		Use:     "aura-cli",
 		Short:   "Allows you to programmatically provision and manage your Aura resources",
 		Version: cfg.Version,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := cfg.SelectProfile(); err != nil {
+				return err
+			}
+			return cfg.Aura.ValidateEnvironment()
+		},
 	}
 
+	cmd.PersistentFlags().String("profile", "", "Name of the configuration profile to use, overriding the AURA_PROFILE environment variable and the current profile")
+	cfg.BindProfile(cmd.PersistentFlags().Lookup("profile"))
+
+	cmd.PersistentFlags().String("credential", "", "Name of the credential to use for this command only, overriding the AURA_CREDENTIAL environment variable, the credential of the profile and the default credential")
+	cfg.BindCredential(cmd.PersistentFlags().Lookup("credential"))
+	cmd.RegisterFlagCompletionFunc("credential", completion.Credentials(cfg))
+
+	cmd.PersistentFlags().Int("retry-max-retries", clicfg.DefaultAuraRetryMaxRetries, "Maximum number of times a rate limited or failed request is retried")
+	cfg.Aura.BindRetryMaxRetries(cmd.PersistentFlags().Lookup("retry-max-retries"))
+
+	cmd.PersistentFlags().Int("retry-max-backoff", clicfg.DefaultAuraRetryMaxBackoff, "Maximum number of seconds to wait between retries")
+	cfg.Aura.BindRetryMaxBackoff(cmd.PersistentFlags().Lookup("retry-max-backoff"))
+
+	cmd.PersistentFlags().Bool("retry-non-idempotent", false, "Also retries requests such as creates on server or network failures, which could apply them twice")
+	cfg.Aura.BindRetryNonIdempotent(cmd.PersistentFlags().Lookup("retry-non-idempotent"))
+
+	cmd.PersistentFlags().Duration("timeout", clicfg.DefaultAuraTimeout, "Maximum duration of a single request to the Aura API, such as 30s or 2m")
+	cfg.Aura.BindTimeout(cmd.PersistentFlags().Lookup("timeout"))
+
+	cmd.PersistentFlags().Bool("no-cache", false, "Sends every request to the Aura API rather than using cached responses")
+	cfg.Aura.BindNoCache(cmd.PersistentFlags().Lookup("no-cache"))
+
+	cmd.PersistentFlags().Bool("no-headers", false, "Omits the header row of the table, csv and tsv output formats")
+
+	cmd.PersistentFlags().StringSlice("columns", nil, "Comma separated keys of the response to show as columns of the table, csv and tsv output formats")
+	cmd.PersistentFlags().Bool("wide", false, "Shows every key of the response as a column of the table, csv and tsv output formats")
+	cmd.MarkFlagsMutuallyExclusive("columns", "wide")
+
+	cmd.PersistentFlags().String("sort-by", "", "Key of the response to sort the items of a list by")
+
+	cmd.PersistentFlags().String("query", "", "A gjson path selecting part of the response to print, such as 'data.#.id'")
+
+	cmd.PersistentFlags().String("template", "", "A Go template rendered over the items of the response with the template output format")
+	cmd.PersistentFlags().String("template-file", "", "Path to a file containing a Go template, used instead of --template")
+	cmd.MarkFlagsMutuallyExclusive("template", "template-file")
+
+	cmd.AddCommand(cache.NewCmd(cfg))
 	cmd.AddCommand(config.NewCmd(cfg))
 	cmd.AddCommand(credential.NewCmd(cfg))
 	cmd.AddCommand(customermanagedkey.NewCmd(cfg))
 	cmd.AddCommand(instance.NewCmd(cfg))
 	cmd.AddCommand(tenant.NewCmd(cfg))
 	cmd.AddCommand(graphanalytics.NewCmd(cfg))
-	if cfg.Aura.AuraBetaEnabled() {
-		cmd.AddCommand(dataapi.NewCmd(cfg))
-	}
+	// Always registered, as a profile enabling beta features is only applied once the command runs
+	cmd.AddCommand(dataapi.NewCmd(cfg))
+
+	chainPersistentPreRun(cmd)
 
 	return cmd
 }
+
+// Cobra only runs the PersistentPreRunE closest to the command being run, so the one of the root command, which applies
+// the profile, is run first by the hooks of the subcommand groups binding their flags
+func chainPersistentPreRun(root *cobra.Command) {
+	rootPreRunE := root.PersistentPreRunE
+
+	var chain func(cmd *cobra.Command)
+	chain = func(cmd *cobra.Command) {
+		for _, child := range cmd.Commands() {
+			if preRunE := child.PersistentPreRunE; preRunE != nil {
+				child.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+					if err := rootPreRunE(cmd, args); err != nil {
+						return err
+					}
+					return preRunE(cmd, args)
+				}
+			}
+			chain(child)
+		}
+	}
+	chain(root)
+}
+
+// Runs the command with these arguments and returns the exit code the process should terminate with.
+// Ctrl-C cancels the context passed to the command, stopping in-flight requests and polling.
+func Execute(ctx context.Context, cmd *cobra.Command, cfg *clicfg.Config, args []string) int {
+	interruptCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	go func() {
+		<-interruptCtx.Done()
+		// Restores the default behaviour, so a second Ctrl-C terminates the process immediately
+		stop()
+	}()
+
+	ctx = api.WithPollReporter(interruptCtx, output.NewPollReporter(cmd, cfg))
+
+	cmd.SetArgs(args)
+
+	// Errors are printed here rather than by cobra, so they follow the selected output format
+	cmd.SilenceErrors = true
+	// The usage printed along with flag errors would break the JSON error document
+	if output.ErrorFormat(cfg, args) == "json" {
+		cmd.SilenceUsage = true
+	}
+
+	err := cmd.ExecuteContext(ctx)
+	if err != nil {
+		output.PrintError(cmd, output.ErrorFormat(cfg, args), err)
+	}
+
+	return clierr.ExitCode(err)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -25,5 +26,5 @@ func main() {
 	cmd := aura.NewCmd(cfg)
 	cmd.SetOut(os.Stdout)
 	cmd.SetErr(os.Stderr)
-	cmd.Execute()
+	os.Exit(aura.Execute(context.Background(), cmd, cfg, os.Args[1:]))
 }
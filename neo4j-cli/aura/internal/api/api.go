@@ -2,13 +2,17 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
 )
 
 const userAgent = "Neo4jCLI/%s"
@@ -30,22 +34,30 @@ type RequestConfig struct {
 	Method      string
 	PostBody    map[string]any
 	QueryParams map[string]string
+	// Always sends a GET request rather than using a cached response, such as when polling for changes
+	NoCache bool
 }
 
-func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (responseBody []byte, statusCode int, err error) {
-	client := http.Client{}
+func MakeRequest(ctx context.Context, cfg *clicfg.Config, path string, config *RequestConfig) (responseBody []byte, statusCode int, err error) {
+	client := http.Client{Timeout: cfg.Aura.Timeout()}
 	var method = config.Method
 	if method == "" {
-		panic(fmt.Sprintf("method not set in requests %s", path))
+		return responseBody, 0, clierr.NewFatalError("method not set in requests %s", path)
 	}
 
-	body := createBody(config.PostBody)
+	body, err := createBody(config.PostBody)
+	if err != nil {
+		return responseBody, 0, err
+	}
 
 	baseUrl := cfg.Aura.BaseUrl()
 	if config.Version == "" {
 		config.Version = AuraApiVersion1
 	}
-	versionPath := getVersionPath(cfg, config.Version)
+	versionPath, err := getVersionPath(cfg, config.Version)
+	if err != nil {
+		return responseBody, 0, err
+	}
 
 	u, _ := url.ParseRequestURI(baseUrl)
 	u = u.JoinPath(versionPath)
@@ -54,10 +66,10 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 	addQueryParams(u, config.QueryParams)
 
 	urlString := u.String()
-	req, err := http.NewRequest(method, urlString, body)
+	req, err := http.NewRequestWithContext(ctx, method, urlString, body)
 
 	if err != nil {
-		panic(err)
+		return responseBody, 0, clierr.NewFatalError("cannot create request to %s: %w", urlString, err)
 	}
 
 	credential, err := cfg.Credentials.Aura.GetDefault()
@@ -65,14 +77,31 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 		return responseBody, 0, err
 	}
 
-	req.Header, err = getHeaders(credential, cfg)
+	req.Header, err = getHeaders(ctx, credential, cfg)
 	if err != nil {
 		return responseBody, 0, err
 	}
 
-	res, err := client.Do(req)
+	// The access token is only known for sure once the headers are set, as it may just have been refreshed
+	cacheTTL := cfg.Aura.CacheTTL()
+	useCache := method == http.MethodGet && cacheTTL > 0 && !config.NoCache
+	account := CacheAccount(credential, cfg.Aura.AuthUrl())
+	if useCache {
+		if cachedBody, ok := readCachedResponse(cfg.Aura.Fs(), account, urlString); ok {
+			return cachedBody, http.StatusOK, nil
+		}
+	}
+
+	res, err := doWithRetry(&client, req, cfg.Aura.RetryConfig(), false)
+	// Even a failed request may have changed the resource
+	if method != http.MethodGet {
+		invalidateCachedResponses(cfg.Aura.Fs(), path)
+	}
 	if err != nil {
-		panic(err)
+		if ctxErr := contextError(req, err, client.Timeout); ctxErr != nil {
+			return responseBody, 0, ctxErr
+		}
+		return responseBody, 0, clierr.NewUpstreamError("request to %s failed: %w", urlString, err)
 	}
 
 	defer res.Body.Close()
@@ -81,7 +110,14 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 		responseBody, err = io.ReadAll(res.Body)
 
 		if err != nil {
-			panic(err)
+			if ctxErr := contextError(req, err, client.Timeout); ctxErr != nil {
+				return responseBody, res.StatusCode, ctxErr
+			}
+			return responseBody, res.StatusCode, clierr.NewUpstreamError("cannot read response from %s: %w", urlString, err).WithStatusCode(res.StatusCode).WithRequestId(getRequestId(res))
+		}
+
+		if useCache && res.StatusCode == http.StatusOK {
+			cacheResponse(cfg.Aura.Fs(), account, urlString, path, responseBody, cacheTTL)
 		}
 
 		return responseBody, res.StatusCode, nil
@@ -90,36 +126,50 @@ func MakeRequest(cfg *clicfg.Config, path string, config *RequestConfig) (respon
 	return responseBody, res.StatusCode, handleResponseError(res, credential, cfg)
 }
 
-func getVersionPath(cfg *clicfg.Config, version AuraApiVersion) string {
+// Reports a request that was cancelled by the user or did not complete in time, nil for any other failure
+func contextError(req *http.Request, err error, timeout time.Duration) error {
+	if errors.Is(req.Context().Err(), context.Canceled) {
+		return clierr.NewInterruptedError("interrupted before %s %s completed, the request may still be processed by Aura", req.Method, req.URL)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return clierr.NewTimeoutError("%s %s did not complete within %s", req.Method, req.URL, timeout)
+	}
+
+	return nil
+}
+
+func getVersionPath(cfg *clicfg.Config, version AuraApiVersion) (string, error) {
 	betaEnabled := cfg.Aura.AuraBetaEnabled()
 
 	switch version {
 	case AuraApiVersion1:
 		if betaEnabled {
-			return cfg.Aura.BetaPathV1()
+			return cfg.Aura.BetaPathV1(), nil
 		}
-		return "v1"
+		return "v1", nil
 	case AuraApiVersion2:
 		if betaEnabled {
-			return cfg.Aura.BetaPathV2()
+			return cfg.Aura.BetaPathV2(), nil
 		}
-		return "v2"
+		return "v2", nil
 	default:
-		panic(fmt.Sprintf("version not set in requests %s", version))
+		return "", clierr.NewFatalError("unsupported API version %s in request", version)
 	}
 }
 
-func createBody(data map[string]any) io.Reader {
+func createBody(data map[string]any) (io.Reader, error) {
 	if data == nil {
-		return nil
+		return nil, nil
 	} else {
 		jsonData, err := json.Marshal(data)
 
 		if err != nil {
-			panic(err)
+			return nil, clierr.NewFatalError("cannot encode request body: %w", err)
 		}
 
-		return bytes.NewBuffer(jsonData)
+		return bytes.NewBuffer(jsonData), nil
 	}
 }
 
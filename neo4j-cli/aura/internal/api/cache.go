@@ -0,0 +1,123 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+)
+
+// A GET response stored on disk, reused until it expires or a request changes the resource
+type cacheEntry struct {
+	Account string    `json:"account"`
+	Url     string    `json:"url"`
+	Path    string    `json:"path"`
+	Expiry  time.Time `json:"expiry"`
+	Body    string    `json:"body"`
+}
+
+// Directory holding one file per cached response
+func cacheDir() string {
+	return filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "cache")
+}
+
+// Responses, and the completion candidates built from them, differ between accounts, which credential names do not tell apart: every credential given by environment
+// variables is named the same, and a name can be reused for another account. The account is identified instead by the
+// client ID, or by a hash of the access token when it is given alone, along with the auth URL that issues its tokens.
+func CacheAccount(credential *credentials.AuraCredential, authUrl string) string {
+	identity := credential.ClientId
+	if identity == "" {
+		hash := sha256.Sum256([]byte(credential.AccessToken))
+		identity = "token:" + hex.EncodeToString(hash[:])
+	}
+	return authUrl + "\n" + identity
+}
+
+// The URL includes the base URL, so the same path on another API is cached separately
+func cacheFile(account string, url string) string {
+	hash := sha256.Sum256([]byte(account + "\n" + url))
+	return filepath.Join(cacheDir(), hex.EncodeToString(hash[:])+".json")
+}
+
+// The cache is best effort, an entry that cannot be read is a miss
+func readCachedResponse(fs afero.Fs, account string, url string) ([]byte, bool) {
+	data, err := afero.ReadFile(fs, cacheFile(account, url))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if entry.Account != account || entry.Url != url || time.Now().After(entry.Expiry) {
+		return nil, false
+	}
+
+	return []byte(entry.Body), true
+}
+
+// Failing to write the cache does not fail the request
+func cacheResponse(fs afero.Fs, account string, url string, path string, body []byte, ttl time.Duration) {
+	data, err := json.Marshal(cacheEntry{
+		Account: account,
+		Url:     url,
+		Path:    path,
+		Expiry:  time.Now().Add(ttl),
+		Body:    string(body),
+	})
+	if err != nil {
+		return
+	}
+
+	if err := fs.MkdirAll(cacheDir(), 0700); err != nil {
+		return
+	}
+	afero.WriteFile(fs, cacheFile(account, url), data, 0600)
+}
+
+// Drops the responses of the resource at path, of the collections containing it and of the resources it contains,
+// for every account, as a request that is not a GET may have changed any of them.
+// Expired entries are dropped along the way.
+func invalidateCachedResponses(fs afero.Fs, path string) {
+	files, err := afero.ReadDir(fs, cacheDir())
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, file := range files {
+		filename := filepath.Join(cacheDir(), file.Name())
+		data, err := afero.ReadFile(fs, filename)
+		if err != nil {
+			continue
+		}
+
+		var entry cacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil || now.After(entry.Expiry) || pathsOverlap(entry.Path, path) {
+			fs.Remove(filename)
+		}
+	}
+}
+
+func pathsOverlap(a string, b string) bool {
+	a = strings.TrimSuffix(a, "/")
+	b = strings.TrimSuffix(b, "/")
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// Removes every cached response
+func ClearCache(cfg *clicfg.Config) error {
+	if err := cfg.Aura.Fs().RemoveAll(cacheDir()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/neo4j/cli/common/clicfg"
@@ -18,49 +22,124 @@ type PollResponse struct {
 	}
 }
 
-func PollInstance(cfg *clicfg.Config, instanceId string, waitingStatus string) (*PollResponse, error) {
-	path := fmt.Sprintf("/instances/%s", instanceId)
-	return Poll(cfg, path, func(status string) bool {
-		return status != waitingStatus
+// Describes the resource awaited by Poll
+type PollTarget struct {
+	// Human readable name of the resource, such as "instance 2f49c2b3"
+	Name          string
+	Path          string
+	Until         PollUntil
+	FailureStatus []string
+}
+
+// Condition ending Poll successfully, either one of the statuses or the resource no longer existing
+type PollUntil struct {
+	Status  []string
+	Deleted bool
+}
+
+// Status reported once a resource awaited until deleted is not found anymore
+const StatusDeleted = "deleted"
+
+func UntilStatus(status ...string) PollUntil {
+	return PollUntil{Status: status}
+}
+
+var UntilDeleted = PollUntil{Deleted: true}
+
+func (u PollUntil) String() string {
+	if u.Deleted {
+		return "deletion"
+	}
+	return quoteJoin(u.Status)
+}
+
+func PollInstance(ctx context.Context, cfg *clicfg.Config, instanceId string, until PollUntil) (*PollResponse, error) {
+	return Poll(ctx, cfg, PollTarget{
+		Name:          fmt.Sprintf("instance %s", instanceId),
+		Path:          fmt.Sprintf("/instances/%s", instanceId),
+		Until:         until,
+		FailureStatus: InstanceFailureStatus,
 	})
 }
 
-func PollSnapshot(cfg *clicfg.Config, instanceId string, snapshotId string) (*PollResponse, error) {
-	path := fmt.Sprintf("/instances/%s/snapshots/%s", instanceId, snapshotId)
-	return Poll(cfg, path, func(status string) bool {
-		return status != SnapshotStatusPending && status != SnapshotStatusInProgress
+func PollSnapshot(ctx context.Context, cfg *clicfg.Config, instanceId string, snapshotId string, until PollUntil) (*PollResponse, error) {
+	return Poll(ctx, cfg, PollTarget{
+		Name:          fmt.Sprintf("snapshot %s", snapshotId),
+		Path:          fmt.Sprintf("/instances/%s/snapshots/%s", instanceId, snapshotId),
+		Until:         until,
+		FailureStatus: SnapshotFailureStatus,
 	})
 }
 
-func PollCMK(cfg *clicfg.Config, cmkId string) (*PollResponse, error) {
-	path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
-	return Poll(cfg, path, func(status string) bool {
-		return status != CMKStatusPending
+func PollCMK(ctx context.Context, cfg *clicfg.Config, cmkId string, until PollUntil) (*PollResponse, error) {
+	return Poll(ctx, cfg, PollTarget{
+		Name:  fmt.Sprintf("customer managed key %s", cmkId),
+		Path:  fmt.Sprintf("/customer-managed-keys/%s", cmkId),
+		Until: until,
 	})
 }
 
-func PollGraphQLDataApi(cfg *clicfg.Config, instanceId string, graphQLDataApiId string, waitingStatus string) (*PollResponse, error) {
-	path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, graphQLDataApiId)
-	return Poll(cfg, path, func(status string) bool {
-		return status != waitingStatus
+func PollGraphQLDataApi(ctx context.Context, cfg *clicfg.Config, instanceId string, graphQLDataApiId string, until PollUntil) (*PollResponse, error) {
+	return Poll(ctx, cfg, PollTarget{
+		Name:          fmt.Sprintf("GraphQL Data API %s", graphQLDataApiId),
+		Path:          fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, graphQLDataApiId),
+		Until:         until,
+		FailureStatus: GraphQLDataApiFailureStatus,
 	})
 }
 
-func PollGraphAnalyticsSessionReady(cfg *clicfg.Config, sessionId string, waitingStatus []string) (*PollResponse, error) {
-	path := fmt.Sprintf("/graph-analytics/sessions/%s", sessionId)
-	return Poll(cfg, path, func(status string) bool {
-		return !slices.Contains(waitingStatus, status)
+func PollGraphAnalyticsSession(ctx context.Context, cfg *clicfg.Config, sessionId string, until PollUntil) (*PollResponse, error) {
+	return Poll(ctx, cfg, PollTarget{
+		Name:          fmt.Sprintf("session %s", sessionId),
+		Path:          fmt.Sprintf("/graph-analytics/sessions/%s", sessionId),
+		Until:         until,
+		FailureStatus: GraphAnalyticsSessionFailureStatus,
 	})
 }
 
-func Poll(cfg *clicfg.Config, url string, cond func(status string) bool) (*PollResponse, error) {
+func PollGraphAnalyticsSessionReady(ctx context.Context, cfg *clicfg.Config, sessionId string) (*PollResponse, error) {
+	return PollGraphAnalyticsSession(ctx, cfg, sessionId, UntilStatus(GraphAnalyticsSessionSuccessStatus...))
+}
+
+// Upper bound of the interval between two status checks when backing off
+const maxPollingBackoffInterval = 5 * time.Minute
+
+// Checks the status of the target until it reaches one of the awaited statuses, or is deleted, failing early on a failure status
+func Poll(ctx context.Context, cfg *clicfg.Config, target PollTarget) (*PollResponse, error) {
 	pollingConfig := cfg.Aura.PollingConfig()
+
+	if pollingConfig.AwaitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, pollingConfig.AwaitTimeout)
+		defer cancel()
+	}
+
+	reporter := pollReporterFrom(ctx)
+	reporter.Start(target.Path)
+	defer reporter.Stop()
+
+	start := time.Now()
+	interval := time.Second * time.Duration(pollingConfig.Interval)
+	lastStatus := ""
 	for i := 0; i < pollingConfig.MaxRetries; i++ {
-		time.Sleep(time.Second * time.Duration(pollingConfig.Interval))
-		resBody, statusCode, err := MakeRequest(cfg, url, &RequestConfig{
-			Method: http.MethodGet,
+		if err := sleep(ctx, interval); err != nil {
+			return nil, pollContextError(ctx, target.Name, lastStatus, pollingConfig.AwaitTimeout)
+		}
+
+		resBody, statusCode, err := MakeRequest(ctx, cfg, target.Path, &RequestConfig{
+			Method:  http.MethodGet,
+			NoCache: true,
 		})
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, pollContextError(ctx, target.Name, lastStatus, pollingConfig.AwaitTimeout)
+			}
+			if cliErr, ok := clierr.AsError(err); ok && cliErr.StatusCode == http.StatusNotFound && target.Until.Deleted {
+				reporter.Transition(lastStatus, StatusDeleted, time.Since(start))
+				var response PollResponse
+				response.Data.Status = StatusDeleted
+				return &response, nil
+			}
 			return nil, clierr.NewUpstreamError("error polling: %w", err)
 		}
 
@@ -70,12 +149,46 @@ func Poll(cfg *clicfg.Config, url string, cond func(status string) bool) (*PollR
 				return nil, clierr.NewUpstreamError("cannot retrieve response polling: %w", err)
 			}
 
+			if response.Data.Status != lastStatus {
+				reporter.Transition(lastStatus, response.Data.Status, time.Since(start))
+				lastStatus = response.Data.Status
+			}
+
 			// Successful poll, return last response
-			if cond(response.Data.Status) {
+			if slices.Contains(target.Until.Status, response.Data.Status) {
 				return &response, nil
 			}
+
+			if slices.Contains(target.FailureStatus, response.Data.Status) {
+				return nil, clierr.NewUpstreamError("%s ended in failure status %q while waiting for %s", target.Name, response.Data.Status, target.Until)
+			}
+		}
+
+		if pollingConfig.Backoff {
+			interval = min(interval*2, max(maxPollingBackoffInterval, interval))
 		}
 	}
 
-	return nil, clierr.NewUpstreamError("hit max retries [%d] polling", pollingConfig.MaxRetries)
+	return nil, clierr.NewTimeoutError("hit max retries [%d] polling", pollingConfig.MaxRetries)
+}
+
+// Tells the user the operation carries on in Aura, as only waiting for it was cancelled or timed out
+func pollContextError(ctx context.Context, name string, lastStatus string, awaitTimeout time.Duration) error {
+	pending := "the operation is still pending in Aura"
+	if lastStatus != "" {
+		pending = fmt.Sprintf("%s with status %s", pending, lastStatus)
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return clierr.NewTimeoutError("timed out after %s waiting for %s, %s", awaitTimeout, name, pending)
+	}
+	return clierr.NewInterruptedError("interrupted while waiting for %s, %s", name, pending)
+}
+
+func quoteJoin(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return strings.Join(quoted, " or ")
 }
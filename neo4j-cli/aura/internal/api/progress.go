@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// Receives the progress of an operation awaited with Poll
+type PollReporter interface {
+	// Called once before the first status check
+	Start(path string)
+	// Called whenever the observed status changes, previous is empty for the first status
+	Transition(previous string, status string, elapsed time.Duration)
+	// Called once polling ends, whatever the outcome
+	Stop()
+}
+
+type pollReporterKey struct{}
+
+// Returns a copy of ctx in which Poll reports progress to reporter
+func WithPollReporter(ctx context.Context, reporter PollReporter) context.Context {
+	return context.WithValue(ctx, pollReporterKey{}, reporter)
+}
+
+func pollReporterFrom(ctx context.Context) PollReporter {
+	if reporter, ok := ctx.Value(pollReporterKey{}).(PollReporter); ok {
+		return reporter
+	}
+	return noopPollReporter{}
+}
+
+type noopPollReporter struct{}
+
+func (noopPollReporter) Start(string)                             {}
+func (noopPollReporter) Transition(string, string, time.Duration) {}
+func (noopPollReporter) Stop()                                    {}
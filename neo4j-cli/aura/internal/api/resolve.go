@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Prefix of a reference forcing the lookup of a resource by name, such as "name:prod"
+const NamePrefix = "name:"
+
+var (
+	hexIdPattern  = regexp.MustCompile(`^[0-9a-f]{8}$`)
+	uuidIdPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+)
+
+// Describes the kind of resource looked up by Resolve
+type ResolveTarget struct {
+	// Human readable name of the kind of resource, such as "instance"
+	Kind     string
+	ListPath string
+	// Shape of the IDs of the resource, used as is without a lookup. When nil, only references with the name prefix
+	// are looked up, as any other reference could be an ID
+	IdPattern *regexp.Regexp
+}
+
+func ResolveInstanceId(ctx context.Context, cfg *clicfg.Config, ref string) (string, error) {
+	return Resolve(ctx, cfg, ResolveTarget{
+		Kind:      "instance",
+		ListPath:  "/instances",
+		IdPattern: hexIdPattern,
+	}, ref)
+}
+
+func ResolveCMKId(ctx context.Context, cfg *clicfg.Config, ref string) (string, error) {
+	return Resolve(ctx, cfg, ResolveTarget{
+		Kind:      "customer managed key",
+		ListPath:  "/customer-managed-keys",
+		IdPattern: uuidIdPattern,
+	}, ref)
+}
+
+func ResolveGraphAnalyticsSessionId(ctx context.Context, cfg *clicfg.Config, ref string) (string, error) {
+	return Resolve(ctx, cfg, ResolveTarget{
+		Kind:     "session",
+		ListPath: "/graph-analytics/sessions",
+	}, ref)
+}
+
+// Resolves the instance first, as GraphQL Data APIs are listed per instance
+func ResolveGraphQLDataApiId(ctx context.Context, cfg *clicfg.Config, instanceRef string, ref string) (instanceId string, graphQLDataApiId string, err error) {
+	instanceId, err = ResolveInstanceId(ctx, cfg, instanceRef)
+	if err != nil {
+		return "", "", err
+	}
+
+	graphQLDataApiId, err = Resolve(ctx, cfg, ResolveTarget{
+		Kind:      "GraphQL Data API",
+		ListPath:  fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId),
+		IdPattern: hexIdPattern,
+	}, ref)
+	if err != nil {
+		return "", "", err
+	}
+
+	return instanceId, graphQLDataApiId, nil
+}
+
+// Returns the ID of the resource a reference points to, which is either its ID, its name, or its name prefixed with "name:".
+// A reference shaped like an ID is used as is, otherwise the resources are listed to find the one with this ID or name.
+func Resolve(ctx context.Context, cfg *clicfg.Config, target ResolveTarget, ref string) (string, error) {
+	name, byName := strings.CutPrefix(ref, NamePrefix)
+	if !byName && (target.IdPattern == nil || target.IdPattern.MatchString(ref)) {
+		return ref, nil
+	}
+
+	resBody, _, err := MakeRequest(ctx, cfg, target.ListPath, &RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var response ListResponseData
+	if err := json.Unmarshal(resBody, &response); err != nil {
+		return "", clierr.NewUpstreamError("cannot retrieve response looking up %s %q: %w", target.Kind, ref, err)
+	}
+
+	ids := []string{}
+	for _, item := range response.Data {
+		id, _ := item["id"].(string)
+		if !byName && id == ref {
+			return id, nil
+		}
+		if itemName, _ := item["name"].(string); itemName == name {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		if byName {
+			return "", clierr.NewUsageError("no %s named %q", target.Kind, name).WithStatusCode(http.StatusNotFound)
+		}
+		return "", clierr.NewUsageError("no %s with the ID or name %q", target.Kind, ref).WithStatusCode(http.StatusNotFound)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", clierr.NewUsageError("%d %ss are named %q, use one of their IDs instead: %s", len(ids), target.Kind, name, strings.Join(ids, ", "))
+	}
+}
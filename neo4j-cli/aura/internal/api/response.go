@@ -1,11 +1,13 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/neo4j/cli/common/clicfg"
@@ -27,24 +29,30 @@ type ServerError struct {
 	Error string `json:"error"`
 }
 
+// Header the Aura API uses to correlate a request with its server side logs
+const requestIdHeader = "X-Request-Id"
+
 func handleResponseError(res *http.Response, credential *credentials.AuraCredential, cfg *clicfg.Config) error {
+	statusCode := res.StatusCode
+	requestId := getRequestId(res)
+
 	resBody, err := io.ReadAll(res.Body)
 
 	if err != nil {
-		panic(clierr.NewFatalError("unexpected error reading response body. %w", err))
+		return clierr.NewUpstreamError("unexpected error reading response body. %w", err).WithStatusCode(statusCode).WithRequestId(requestId)
 	}
 
-	switch statusCode := res.StatusCode; statusCode {
+	switch statusCode {
 	// redirection messages
 	case http.StatusPermanentRedirect:
-		panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+		return clientBugError(statusCode, requestId)
 	// client error responses
 	case http.StatusBadRequest:
 		var errorResponse ErrorResponse
 
 		err = json.Unmarshal(resBody, &errorResponse)
 		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+			return unexpectedStatusError(res)
 		}
 
 		messages := []string{}
@@ -56,41 +64,34 @@ func handleResponseError(res *http.Response, credential *credentials.AuraCredent
 			messages = append(messages, message)
 		}
 
-		return clierr.NewUpstreamError("%s", messages)
+		return clierr.NewUpstreamError("%s", messages).WithStatusCode(statusCode).WithRequestId(requestId).WithDetails(errorResponse.details()...)
 	case http.StatusUnauthorized:
-		return formatAuthorizationError(resBody, statusCode, credential, cfg)
+		return formatAuthorizationError(resBody, statusCode, requestId, credential, cfg)
 	case http.StatusForbidden:
 		// Requested endpoint is forbidden
 		var serverError ServerError
 		err := json.Unmarshal(resBody, &serverError)
 		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+			return unexpectedStatusError(res)
 		}
 		if serverError.Error != "" {
-			return clierr.NewUpstreamError(serverError.Error)
-		}
-
-		return formatAuthorizationError(resBody, statusCode, credential, cfg)
-	case http.StatusNotFound:
-		var errorResponse ErrorResponse
-
-		err = json.Unmarshal(resBody, &errorResponse)
-		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+			return clierr.NewUpstreamError(serverError.Error).WithStatusCode(statusCode).WithRequestId(requestId).WithDetails(clierr.Detail{Message: serverError.Error})
 		}
 
-		messages := []string{}
-		for _, e := range errorResponse.Errors {
-			messages = append(messages, e.Message)
-		}
-
-		return clierr.NewUpstreamError("%s", messages)
-	case http.StatusMethodNotAllowed:
+		return formatAuthorizationError(resBody, statusCode, requestId, credential, cfg)
+	case http.StatusUnsupportedMediaType:
+		return clientBugError(statusCode, requestId)
+	case http.StatusTooManyRequests:
+		retryAfter := res.Header.Get("Retry-After")
+		return clierr.NewUpstreamError("server rate limit exceeded, suggested cool-off period is %s seconds before rerunning the command", retryAfter).WithStatusCode(statusCode).WithRequestId(requestId)
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusConflict,
+		// server error responses
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
 		var errorResponse ErrorResponse
 
 		err = json.Unmarshal(resBody, &errorResponse)
 		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+			return unexpectedStatusError(res)
 		}
 
 		messages := []string{}
@@ -98,48 +99,53 @@ func handleResponseError(res *http.Response, credential *credentials.AuraCredent
 			messages = append(messages, e.Message)
 		}
 
-		return clierr.NewUpstreamError("%s", messages)
-	case http.StatusConflict:
-		var errorResponse ErrorResponse
-
-		err = json.Unmarshal(resBody, &errorResponse)
-		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+		return clierr.NewUpstreamError("%s%s", messages, retryAfterHint(res)).WithStatusCode(statusCode).WithRequestId(requestId).WithDetails(errorResponse.details()...)
+	default:
+		// Server errors not listed above, such as a 520 from a proxy, are not caused by the request the CLI made
+		if statusCode >= http.StatusInternalServerError {
+			return unexpectedStatusError(res)
 		}
+		return clierr.NewFatalError("unexpected status code %d and body %s running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, resBody, os.Args[1:]).WithStatusCode(statusCode).WithRequestId(requestId)
+	}
+}
 
-		messages := []string{}
-		for _, e := range errorResponse.Errors {
-			messages = append(messages, e.Message)
-		}
+// An error response without a body the CLI can parse, such as an HTML page served by a proxy in front of the Aura API
+func unexpectedStatusError(res *http.Response) error {
+	status := strings.TrimSpace(fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)))
+	return clierr.NewUpstreamError("unexpected response from the Aura API [status %s]%s", status, retryAfterHint(res)).WithStatusCode(res.StatusCode).WithRequestId(getRequestId(res))
+}
 
-		return clierr.NewUpstreamError("%s", messages)
-	case http.StatusUnsupportedMediaType:
-		panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
-	case http.StatusTooManyRequests:
-		retryAfter := res.Header.Get("Retry-After")
-		return clierr.NewUpstreamError("server rate limit exceeded, suggested cool-off period is %s seconds before rerunning the command", retryAfter)
-	// server error responses
-	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
-		var errorResponse ErrorResponse
+// A status the Aura API only returns when the CLI itself sent a malformed request
+func clientBugError(statusCode int, requestId string) error {
+	return clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]).WithStatusCode(statusCode).WithRequestId(requestId)
+}
 
-		err = json.Unmarshal(resBody, &errorResponse)
-		if err != nil {
-			panic(clierr.NewFatalError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
-		}
+// The wait a server asked for when it was not retried, as it exceeded the maximum backoff or the retries were used up
+func retryAfterHint(res *http.Response) string {
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return ""
+	}
+	if _, err := strconv.Atoi(header); err == nil {
+		return fmt.Sprintf(", suggested cool-off period is %s seconds before rerunning the command", header)
+	}
+	return fmt.Sprintf(", suggested cool-off period is until %s before rerunning the command", header)
+}
 
-		messages := []string{}
-		for _, e := range errorResponse.Errors {
-			messages = append(messages, e.Message)
-		}
+func getRequestId(res *http.Response) string {
+	return res.Header.Get(requestIdHeader)
+}
 
-		return clierr.NewUpstreamError("%s", messages)
-	default:
-		panic(clierr.NewFatalError("unexpected status code %d and body %s running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, resBody, os.Args[1:]))
+func (r ErrorResponse) details() []clierr.Detail {
+	details := []clierr.Detail{}
+	for _, e := range r.Errors {
+		details = append(details, clierr.Detail{Message: e.Message, Reason: e.Reason, Field: e.Field})
 	}
+	return details
 }
 
-func getHeaders(credential *credentials.AuraCredential, cfg *clicfg.Config) (http.Header, error) {
-	token, err := getToken(credential, cfg)
+func getHeaders(ctx context.Context, credential *credentials.AuraCredential, cfg *clicfg.Config) (http.Header, error) {
+	token, err := getToken(ctx, credential, cfg)
 
 	if err != nil {
 		return nil, err
@@ -179,6 +185,35 @@ const (
 	SnapshotStatusFailed     string = "Failed"
 )
 
+// Statuses in which awaiting an operation on a resource ends, successfully or not.
+// A resource in a failure status will not reach the awaited status on its own.
+var (
+	InstanceSuccessStatus              = []string{InstanceStatusRunning}
+	InstanceFailureStatus              = []string{InstanceStatusLoadingFailed}
+	SnapshotSuccessStatus              = []string{SnapshotStatusCompleted}
+	SnapshotFailureStatus              = []string{SnapshotStatusFailed}
+	CMKSuccessStatus                   = []string{CMKStatusReady}
+	GraphQLDataApiFailureStatus        = []string{GraphQLDataApiStatusError}
+	GraphAnalyticsSessionSuccessStatus = []string{GraphAnalyticsSessionReady}
+	GraphAnalyticsSessionFailureStatus = []string{GraphAnalyticsSessionFailed, GraphAnalyticsSessionExpired}
+)
+
+// Statuses a resource can reach, which a wait command accepts for --for-status
+var (
+	InstanceStatuses = []string{
+		InstanceStatusCreating, InstanceStatusDestroying, InstanceStatusRunning, InstanceStatusPausing, InstanceStatusPaused,
+		InstanceStatusSuspending, InstanceStatusSuspended, InstanceStatusResuming, InstanceStatusLoading, InstanceStatusLoadingFailed,
+		InstanceStatusRestoring, InstanceStatusUpdating, InstanceStatusOverwriting,
+	}
+	SnapshotStatuses       = []string{SnapshotStatusPending, SnapshotStatusInProgress, SnapshotStatusCompleted, SnapshotStatusFailed}
+	CMKStatuses            = []string{CMKStatusPending, CMKStatusReady}
+	GraphQLDataApiStatuses = []string{
+		GraphQLDataApiStatusCreating, GraphQLDataApiStatusReady, GraphQLDataApiStatusUpdating, GraphQLDataApiStatusDeleting,
+		GraphQLDataApiStatusPausing, GraphQLDataApiStatusPaused, GraphQLDataApiStatusResuming, GraphQLDataApiStatusError,
+	}
+	GraphAnalyticsSessionStatuses = []string{GraphAnalyticsSessionCreating, GraphAnalyticsSessionReady, GraphAnalyticsSessionExpired, GraphAnalyticsSessionFailed}
+)
+
 // Response Body of Create and Get Instance for successful requests
 type CreateInstanceResponse struct {
 	Data struct {
@@ -256,18 +291,13 @@ const (
 	GraphAnalyticsSessionFailed   = "Failed"
 )
 
-var GraphAnalyticsSessionWaitingStatus = []string{
-	GraphAnalyticsSessionCreating,
-	GraphAnalyticsSessionInitial,
-}
-
 type ResponseData interface {
 	AsArray() []map[string]any
 	GetSingleOrError() (map[string]any, error)
 }
 
 type ListResponseData struct {
-	Data []map[string]any `json:"data"`
+	Data []map[string]any `json:"data" yaml:"data"`
 }
 
 func (d ListResponseData) GetSingleOrError() (map[string]any, error) {
@@ -282,8 +312,8 @@ func (d ListResponseData) AsArray() []map[string]any {
 }
 
 type SingleValueResponseData struct {
-	Data   map[string]any   `json:"data"`
-	Errors []map[string]any `json:"errors,omitempty"`
+	Data   map[string]any   `json:"data" yaml:"data"`
+	Errors []map[string]any `json:"errors,omitempty" yaml:"errors,omitempty"`
 }
 
 func (d SingleValueResponseData) GetSingleOrError() (map[string]any, error) {
@@ -306,29 +336,29 @@ func NewResponseData(data []map[string]any) ResponseData {
 	}
 }
 
-func ParseBody(body []byte) ResponseData {
+func ParseBody(body []byte) (ResponseData, error) {
 	var listResponseData ListResponseData
 	err := json.Unmarshal(body, &listResponseData)
 
 	// Try unmarshalling array first, if not it creates an array from the single item
 	if err == nil {
-		return listResponseData
+		return listResponseData, nil
 	} else {
 		var singleValueResponseData SingleValueResponseData
 		err := json.Unmarshal(body, &singleValueResponseData)
 		if err != nil {
-			panic(err)
+			return nil, clierr.NewUpstreamError("cannot parse response body: %w", err)
 		}
-		return singleValueResponseData
+		return singleValueResponseData, nil
 	}
 }
 
-func formatAuthorizationError(resBody []byte, statusCode int, credential *credentials.AuraCredential, cfg *clicfg.Config) error {
+func formatAuthorizationError(resBody []byte, statusCode int, requestId string, credential *credentials.AuraCredential, cfg *clicfg.Config) error {
 	var errorResponse ErrorResponse
 
 	err := json.Unmarshal(resBody, &errorResponse)
 	if err != nil {
-		return clierr.NewUsageError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:])
+		return clierr.NewUsageError("unexpected error [status %d] running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]).WithStatusCode(statusCode).WithRequestId(requestId)
 	}
 
 	messages := []string{}
@@ -337,7 +367,10 @@ func formatAuthorizationError(resBody []byte, statusCode int, credential *creden
 	}
 
 	_, err = cfg.Credentials.Aura.ClearAccessToken(credential)
-	if err != nil {
+	if cfg.Credentials.Aura.FromEnvironment() && credential.ClientId == "" {
+		// A token given without a client ID and secret cannot be refreshed by the CLI
+		messages = append(messages, fmt.Sprintf("Request failed authorization - the access token given by %s was rejected, please provide a new one", credentials.AccessTokenEnvironmentVariable))
+	} else if err != nil {
 		messages = append(messages, "Request failed authorization - attempted to clear the access token but encountered an error, please report an issue in https://github.com/neo4j/cli")
 	} else {
 		messages = append(messages, "Request failed authorization - access token has been cleared and will be refreshed on next request - please retry the command")
@@ -345,5 +378,5 @@ func formatAuthorizationError(resBody []byte, statusCode int, credential *creden
 
 	return clierr.NewUsageError(`[
 	%s
-]`, strings.Join(messages, ",\n\t"))
+]`, strings.Join(messages, ",\n\t")).WithStatusCode(statusCode).WithRequestId(requestId).WithDetails(errorResponse.details()...)
 }
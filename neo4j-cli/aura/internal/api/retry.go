@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/neo4j/cli/common/clicfg"
+)
+
+const baseBackoff = time.Second
+
+// Methods that can be replayed without changing the outcome on the server
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// Sends the request, retrying rate limited requests, unavailable upstream servers and network failures
+func doWithRetry(client *http.Client, req *http.Request, retryConfig clicfg.RetryConfig, replayable bool) (*http.Response, error) {
+	replayable = replayable || retryConfig.NonIdempotent || slices.Contains(idempotentMethods, req.Method)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		res, err := client.Do(req)
+
+		wait, retry := shouldRetry(req, res, err, attempt, retryConfig, replayable)
+		if !retry {
+			return res, err
+		}
+
+		if res != nil {
+			// Drain the body so the connection can be reused for the next attempt
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error, attempt int, retryConfig clicfg.RetryConfig, replayable bool) (time.Duration, bool) {
+	if attempt >= retryConfig.MaxRetries {
+		return 0, false
+	}
+
+	maxBackoff := time.Duration(retryConfig.MaxBackoff) * time.Second
+
+	if err != nil {
+		// A cancelled request fails the same way on every attempt
+		if req.Context().Err() != nil {
+			return 0, false
+		}
+
+		return backoff(attempt, maxBackoff), replayable
+	}
+
+	switch res.StatusCode {
+	// A rate limited request has not been processed, so it is safe to replay whatever the method
+	case http.StatusTooManyRequests:
+		return retryAfter(res, attempt, maxBackoff)
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !replayable {
+			return 0, false
+		}
+		return retryAfter(res, attempt, maxBackoff)
+	default:
+		return 0, false
+	}
+}
+
+// Honours the Retry-After header when present. A request retried earlier than asked would only be rejected again, so when
+// the server asks to wait longer than maxBackoff, or past the deadline of the command, the response is returned instead
+// and its error reports the wait.
+func retryAfter(res *http.Response, attempt int, maxBackoff time.Duration) (time.Duration, bool) {
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return backoff(attempt, maxBackoff), true
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		wait = max(time.Until(date), 0)
+	} else {
+		return backoff(attempt, maxBackoff), true
+	}
+
+	if wait > maxBackoff {
+		return 0, false
+	}
+	if deadline, ok := res.Request.Context().Deadline(); ok && time.Until(deadline) < wait {
+		return 0, false
+	}
+	return wait, true
+}
+
+// Exponential backoff with full jitter, capped at maxBackoff
+func backoff(attempt int, maxBackoff time.Duration) time.Duration {
+	ceiling := maxBackoff
+	// Guards against the shift overflowing for very high retry counts
+	if attempt < 32 {
+		ceiling = min(baseBackoff<<attempt, maxBackoff)
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// Waits for the duration, returning early when the context is done
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
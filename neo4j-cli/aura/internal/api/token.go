@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,7 +14,7 @@ import (
 	"github.com/neo4j/cli/common/clierr"
 )
 
-func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (string, error) {
+func getToken(ctx context.Context, credential *credentials.AuraCredential, cfg *clicfg.Config) (string, error) {
 	if credential.HasValidAccessToken() {
 		return credential.AccessToken, nil
 	}
@@ -24,9 +25,9 @@ func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (strin
 
 	url := cfg.Aura.AuthUrl()
 
-	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(data.Encode()))
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		return "", clierr.NewFatalError("can't retrieve authentication token. %w", err)
 	}
 
 	version := cfg.Version
@@ -37,35 +38,43 @@ func getToken(credential *credentials.AuraCredential, cfg *clicfg.Config) (strin
 	}
 	req.SetBasicAuth(credential.ClientId, credential.ClientSecret)
 
-	client := http.Client{}
+	client := http.Client{Timeout: cfg.Aura.Timeout()}
 
-	res, err := client.Do(req)
+	// Requesting a token has no side effects, so it is always safe to replay
+	res, err := doWithRetry(&client, req, cfg.Aura.RetryConfig(), true)
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		if ctxErr := contextError(req, err, client.Timeout); ctxErr != nil {
+			return "", ctxErr
+		}
+		return "", clierr.NewUpstreamError("can't retrieve authentication token. %w", err)
 	}
 	defer res.Body.Close()
 
-	switch statusCode := res.StatusCode; statusCode {
-	case http.StatusUnauthorized:
-		return "", clierr.NewUsageError("the provided credentials are invalid, expired, or revoked")
-	case http.StatusBadRequest:
-	case http.StatusForbidden:
-	case http.StatusNotFound:
-		panic(clierr.NewFatalError("can't retrieve authentication token. Response status code [%d]", http.StatusBadRequest))
+	requestId := getRequestId(res)
+
+	switch statusCode := res.StatusCode; {
+	case statusCode == http.StatusUnauthorized:
+		return "", clierr.NewUsageError("the provided credentials are invalid, expired, or revoked").WithStatusCode(statusCode).WithRequestId(requestId)
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusForbidden, statusCode == http.StatusNotFound:
+		return "", clierr.NewFatalError("can't retrieve authentication token. Response status code [%d]", statusCode).WithStatusCode(statusCode).WithRequestId(requestId)
+	case !isSuccessful(statusCode):
+		return "", clierr.NewUpstreamError("can't retrieve authentication token. Response status code [%d]", statusCode).WithStatusCode(statusCode).WithRequestId(requestId)
 	}
 
 	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		return "", clierr.NewUpstreamError("can't retrieve authentication token. %w", err).WithRequestId(requestId)
 	}
 
 	var grant Grant
 
 	err = json.Unmarshal(resBody, &grant)
 	if err != nil {
-		panic(clierr.NewFatalError("can't retrieve authentication token. %w", err))
+		return "", clierr.NewFatalError("can't retrieve authentication token. %w", err).WithRequestId(requestId)
 	}
 
-	cfg.Credentials.Aura.UpdateAccessToken(credential, grant.AccessToken, grant.ExpiresIn)
-	return grant.AccessToken, err
+	if _, err := cfg.Credentials.Aura.UpdateAccessToken(credential, grant.AccessToken, grant.ExpiresIn); err != nil {
+		return "", err
+	}
+	return grant.AccessToken, nil
 }
@@ -0,0 +1,200 @@
+package completion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/fileutils"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Completes the value of a flag or argument, as expected by cobra
+type Func = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// Lifetime of cached candidates, long enough to cover the successive completions of one command line
+const cacheTTL = time.Minute
+
+type cacheEntry struct {
+	Candidates []string  `json:"candidates"`
+	Expiry     time.Time `json:"expiry"`
+}
+
+func Instances(cfg *clicfg.Config) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return listCandidates(cmd, cfg, "/instances", idAndName), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Completes the instance ID taken as the only argument of a command
+func InstanceArg(cfg *clicfg.Config) Func {
+	complete := Instances(cfg)
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
+
+func Tenants(cfg *clicfg.Config) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return listCandidates(cmd, cfg, "/tenants", idAndName), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Completes the names of the stored credentials, which are known without asking the Aura API
+func Credentials(cfg *clicfg.Config) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		names := []string{}
+		for _, credential := range cfg.Credentials.Aura.List() {
+			names = append(names, credential.Name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Completes the snapshots of the instance given by the flag, or else by the first argument of the command
+func Snapshots(cfg *clicfg.Config, instanceIdFlag string) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		instanceRef, _ := cmd.Flags().GetString(instanceIdFlag)
+		if instanceRef == "" && len(args) > 0 {
+			instanceRef = args[0]
+		}
+		if instanceRef == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, instanceRef)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		path := fmt.Sprintf("/instances/%s/snapshots", instanceId)
+		return listCandidates(cmd, cfg, path, snapshotIdAndTimestamp), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Offers the ID of each resource described by its name, and the name described by the ID
+// so that either can be typed, leaving out names the shell would split
+func idAndName(item map[string]any) []string {
+	id, _ := item["id"].(string)
+	name, _ := item["name"].(string)
+	if id == "" {
+		return nil
+	}
+
+	candidates := []string{candidate(id, name)}
+	if name != "" && name != id && !strings.ContainsAny(name, " \t\n") {
+		candidates = append(candidates, candidate(name, id))
+	}
+	return candidates
+}
+
+func snapshotIdAndTimestamp(item map[string]any) []string {
+	id, _ := item["snapshot_id"].(string)
+	if id == "" {
+		return nil
+	}
+
+	timestamp, _ := item["timestamp"].(string)
+	status, _ := item["status"].(string)
+	return []string{candidate(id, strings.TrimSpace(fmt.Sprintf("%s %s", timestamp, status)))}
+}
+
+// Cobra shows the description after a tab next to the candidate, in shells supporting it
+func candidate(value string, description string) string {
+	if description == "" {
+		return value
+	}
+	return fmt.Sprintf("%s\t%s", value, description)
+}
+
+// Returns the candidates built from the resources listed at path, served from the cache while fresh.
+// Completion fails silently, the shell then offers nothing.
+func listCandidates(cmd *cobra.Command, cfg *clicfg.Config, path string, build func(item map[string]any) []string) []string {
+	// Cobra does not run the hooks of the completed command, the profile given by its flags is applied here
+	if err := cfg.SelectProfile(); err != nil {
+		return nil
+	}
+
+	credential, err := cfg.Credentials.Aura.GetDefault()
+	if err != nil {
+		return nil
+	}
+
+	// Results depend on the account and API queried, not only on the path
+	key := fmt.Sprintf("%s %s %s", api.CacheAccount(credential, cfg.Aura.AuthUrl()), cfg.Aura.BaseUrl(), path)
+
+	fs := cfg.Aura.Fs()
+	cache := readCache(fs)
+	if entry, ok := cache[key]; ok && time.Now().Before(entry.Expiry) {
+		return entry.Candidates
+	}
+
+	resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
+		Method: http.MethodGet,
+	})
+	if err != nil || statusCode != http.StatusOK {
+		return nil
+	}
+
+	items, err := api.ParseBody(resBody)
+	if err != nil {
+		return nil
+	}
+
+	candidates := []string{}
+	for _, item := range items.AsArray() {
+		candidates = append(candidates, build(item)...)
+	}
+
+	cache[key] = cacheEntry{Candidates: candidates, Expiry: time.Now().Add(cacheTTL)}
+	writeCache(fs, cache)
+
+	return candidates
+}
+
+func cachePath() string {
+	return filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "completion-cache.json")
+}
+
+// A missing or unreadable cache is treated as empty
+func readCache(fs afero.Fs) map[string]cacheEntry {
+	cache := map[string]cacheEntry{}
+	if !fileutils.FileExists(fs, cachePath()) {
+		return cache
+	}
+
+	data, err := afero.ReadFile(fs, cachePath())
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return map[string]cacheEntry{}
+	}
+	return cache
+}
+
+// Drops expired entries so the file does not grow with every path ever completed
+func writeCache(fs afero.Fs, cache map[string]cacheEntry) {
+	now := time.Now()
+	for key, entry := range cache {
+		if now.After(entry.Expiry) {
+			delete(cache, key)
+		}
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	afero.WriteFile(fs, cachePath(), data, 0600)
+}
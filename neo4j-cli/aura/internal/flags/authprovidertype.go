@@ -1,9 +1,14 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
 
 type AuthProviderType string
 
+var AuthProviderTypeValues = []string{"api-key", "jwks"}
+
 // String is used both by fmt.Print and by Cobra in help text
 func (e *AuthProviderType) String() string {
 	return string(*e)
@@ -11,13 +16,11 @@ func (e *AuthProviderType) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *AuthProviderType) Set(v string) error {
-	switch v {
-	case "api-key", "jwks":
-		*e = AuthProviderType(v)
-		return nil
-	default:
+	if !slices.Contains(AuthProviderTypeValues, v) {
 		return errors.New(`must be one of "api-key" or "jwks"`)
 	}
+	*e = AuthProviderType(v)
+	return nil
 }
 
 // Type is only used in help text
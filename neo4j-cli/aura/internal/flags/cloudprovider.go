@@ -1,9 +1,14 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
 
 type CloudProvider string
 
+var CloudProviderValues = []string{"aws", "azure", "gcp"}
+
 // String is used both by fmt.Print and by Cobra in help text
 func (e *CloudProvider) String() string {
 	return string(*e)
@@ -11,13 +16,11 @@ func (e *CloudProvider) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *CloudProvider) Set(v string) error {
-	switch v {
-	case "aws", "azure", "gcp":
-		*e = CloudProvider(v)
-		return nil
-	default:
+	if !slices.Contains(CloudProviderValues, v) {
 		return errors.New(`must be one of "aws", "azure", or "gcp"`)
 	}
+	*e = CloudProvider(v)
+	return nil
 }
 
 // Type is only used in help text
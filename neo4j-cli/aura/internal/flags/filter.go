@@ -0,0 +1,18 @@
+package flags
+
+import "github.com/spf13/cobra"
+
+const (
+	FilterFlag = "filter"
+	NameFlag   = "name"
+)
+
+// Adds the flags selecting the items printed by a list command
+func AddFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray(FilterFlag, nil, "Only lists the items matching key=value or key!=value, where the value can contain * and ? wildcards. Can be repeated")
+}
+
+// Adds the flag selecting the items printed by a list command by name, for resources having one
+func AddNameFilterFlag(cmd *cobra.Command) {
+	cmd.Flags().String(NameFlag, "", "Only lists the items whose name matches the pattern, which can contain * and ? wildcards")
+}
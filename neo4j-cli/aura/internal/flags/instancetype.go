@@ -1,9 +1,14 @@
 package flags
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
 
 type InstanceType string
 
+var InstanceTypeValues = []string{"free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", "enterprise-ds"}
+
 // String is used both by fmt.Print and by Cobra in help text
 func (e *InstanceType) String() string {
 	return string(*e)
@@ -11,13 +16,11 @@ func (e *InstanceType) String() string {
 
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (e *InstanceType) Set(v string) error {
-	switch v {
-	case "free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", "enterprise-ds":
-		*e = InstanceType(v)
-		return nil
-	default:
+	if !slices.Contains(InstanceTypeValues, v) {
 		return errors.New(`must be one of "free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", or "enterprise-ds"`)
 	}
+	*e = InstanceType(v)
+	return nil
 }
 
 // Type is only used in help text
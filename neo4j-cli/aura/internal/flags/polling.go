@@ -0,0 +1,53 @@
+package flags
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	PollingIntervalFlag   = "polling-interval"
+	PollingMaxRetriesFlag = "polling-max-retries"
+	PollingBackoffFlag    = "polling-backoff"
+	AwaitTimeoutFlag      = "await-timeout"
+)
+
+// Adds the flags controlling how a command supporting --await polls for the status of the operation
+func AddPollingFlags(cmd *cobra.Command, cfg *clicfg.Config) {
+	cmd.Flags().Int(PollingIntervalFlag, clicfg.DefaultAuraPollingInterval, "Number of seconds between two status checks when awaiting")
+	validateFlag(cmd, cfg, PollingIntervalFlag, "polling.interval")
+	cmd.Flags().Int(PollingMaxRetriesFlag, clicfg.DefaultAuraPollingMaxRetries, "Maximum number of status checks when awaiting")
+	validateFlag(cmd, cfg, PollingMaxRetriesFlag, "polling.max-retries")
+	cmd.Flags().Bool(PollingBackoffFlag, false, "Doubles the interval after each status check when awaiting")
+	cmd.Flags().Duration(AwaitTimeoutFlag, 0, "Maximum duration to await, such as 10m or 1h, no limit when 0s")
+	validateFlag(cmd, cfg, AwaitTimeoutFlag, "await-timeout")
+}
+
+// Checks the value of a flag like the value of the config key it overrides, so a negative value is rejected before any request is made
+func validateFlag(cmd *cobra.Command, cfg *clicfg.Config, name string, key string) {
+	flag := cmd.Flags().Lookup(name)
+	flag.Value = &validatedValue{Value: flag.Value, key: key, cfg: cfg}
+}
+
+type validatedValue struct {
+	pflag.Value
+	key string
+	cfg *clicfg.Config
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (v *validatedValue) Set(value string) error {
+	if err := v.cfg.Aura.ValidateValue(v.key, value); err != nil {
+		return err
+	}
+	return v.Value.Set(value)
+}
+
+// Binds the polling flags of the running command, as each command supporting --await defines its own
+func BindPollingFlags(cmd *cobra.Command, cfg *clicfg.Config) {
+	cfg.Aura.BindPollingInterval(cmd.Flags().Lookup(PollingIntervalFlag))
+	cfg.Aura.BindPollingMaxRetries(cmd.Flags().Lookup(PollingMaxRetriesFlag))
+	cfg.Aura.BindPollingBackoff(cmd.Flags().Lookup(PollingBackoffFlag))
+	cfg.Aura.BindAwaitTimeout(cmd.Flags().Lookup(AwaitTimeoutFlag))
+}
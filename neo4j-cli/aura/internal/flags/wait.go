@@ -0,0 +1,72 @@
+package flags
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ForStatusFlag   = "for-status"
+	ForDeletionFlag = "for-deletion"
+)
+
+// Status is one of the statuses a resource can reach, which differ for each kind of resource
+type Status struct {
+	value    string
+	statuses []string
+}
+
+// String is used both by fmt.Print and by Cobra in help text
+func (e *Status) String() string {
+	return e.value
+}
+
+// Set must have pointer receiver so it doesn't change the value of a copy
+func (e *Status) Set(v string) error {
+	if !slices.Contains(e.statuses, v) {
+		return fmt.Errorf("must be one of %s", quotedValues(e.statuses))
+	}
+	e.value = v
+	return nil
+}
+
+// Type is only used in help text
+func (e *Status) Type() string {
+	return "status"
+}
+
+// Lists values the way the messages of the other flag types do, such as "a", "b", or "c"
+func quotedValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	if len(quoted) <= 2 {
+		return strings.Join(quoted, " or ")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+}
+
+// Adds the flags selecting what a wait command waits for, along with the polling flags.
+// --for-status only accepts the statuses given, so a mistyped status fails instead of polling until the retries run out.
+func AddWaitFlags(cmd *cobra.Command, cfg *clicfg.Config, defaultStatus string, statuses []string) {
+	cmd.Flags().Var(&Status{value: defaultStatus, statuses: statuses}, ForStatusFlag, "The status to wait for")
+	cmd.RegisterFlagCompletionFunc(ForStatusFlag, cobra.FixedCompletions(statuses, cobra.ShellCompDirectiveNoFileComp))
+	cmd.Flags().Bool(ForDeletionFlag, false, "Waits until the resource is deleted")
+	cmd.MarkFlagsMutuallyExclusive(ForStatusFlag, ForDeletionFlag)
+	AddPollingFlags(cmd, cfg)
+}
+
+// Returns the condition selected by the wait flags of the running command
+func WaitUntil(cmd *cobra.Command) api.PollUntil {
+	if forDeletion, _ := cmd.Flags().GetBool(ForDeletionFlag); forDeletion {
+		return api.UntilDeleted
+	}
+	return api.UntilStatus(cmd.Flags().Lookup(ForStatusFlag).Value.String())
+}
@@ -0,0 +1,84 @@
+package output
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Returns the columns configured for a resource with its columns.<resource> key, or else the fields given.
+// Only list and get commands use it, as commands changing a resource may return fields that must not be left out,
+// such as the password of a new instance.
+func DefaultColumns(cfg *clicfg.Config, resource string, fields []string) []string {
+	if columns := cfg.Aura.Columns(resource); len(columns) > 0 {
+		return columns
+	}
+	return fields
+}
+
+// Selects the columns of tabular output, from the most to the least specific of --columns, --wide and the fields of the command
+func selectColumns(cmd *cobra.Command, values api.ResponseData, fields []string) []string {
+	if columns, _ := cmd.Flags().GetStringSlice("columns"); len(columns) > 0 {
+		return columns
+	}
+
+	if flagValue(cmd, "wide") == "true" {
+		return wideColumns(values, fields)
+	}
+
+	return fields
+}
+
+// Returns the fields of the command followed by every other key present in the response
+func wideColumns(values api.ResponseData, fields []string) []string {
+	others := []string{}
+	for _, row := range values.AsArray() {
+		for key := range row {
+			if !slices.Contains(fields, key) && !slices.Contains(others, key) {
+				others = append(others, key)
+			}
+		}
+	}
+
+	sort.Strings(others)
+	return append(slices.Clone(fields), others...)
+}
+
+// Orders the items of a list response by the value of --sort-by, items without the key coming last
+func sortValues(cmd *cobra.Command, values api.ResponseData) api.ResponseData {
+	key := flagValue(cmd, "sort-by")
+	if _, ok := values.(api.ListResponseData); !ok || key == "" {
+		return values
+	}
+
+	rows := slices.Clone(values.AsArray())
+	slices.SortStableFunc(rows, func(a, b map[string]any) int {
+		return compareValues(a[key], b[key])
+	})
+	return api.NewResponseData(rows)
+}
+
+// Compares numbers numerically and any other value by its formatted text, nil being the greatest
+func compareValues(a any, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	aNumber, aIsNumber := a.(float64)
+	bNumber, bIsNumber := b.(float64)
+	if aIsNumber && bIsNumber {
+		return cmp.Compare(aNumber, bNumber)
+	}
+
+	return cmp.Compare(formatCell(a), formatCell(b))
+}
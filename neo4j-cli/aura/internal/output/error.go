@@ -0,0 +1,73 @@
+package output
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+type errorDocument struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code      string          `json:"code"`
+	ExitCode  int             `json:"exit_code"`
+	Status    int             `json:"status,omitempty"`
+	Message   string          `json:"message"`
+	Errors    []clierr.Detail `json:"errors,omitempty"`
+	RequestId string          `json:"request_id,omitempty"`
+}
+
+// Returns the output format given by the --output argument, or else by the configuration when the argument is missing or invalid.
+// The flag is looked up in the raw arguments, as it is only bound once every flag of the command has been parsed,
+// while errors such as an invalid flag value are raised before that.
+func ErrorFormat(cfg *clicfg.Config, args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+
+		value, found := strings.CutPrefix(arg, "--output=")
+		if !found && arg == "--output" && i+1 < len(args) {
+			value, found = args[i+1], true
+		}
+		if found && slices.Contains(clicfg.ValidOutputValues[:], value) {
+			return value
+		}
+	}
+	return cfg.Aura.Output()
+}
+
+// Prints the error returned by a command to stderr, as a JSON document when the format is JSON
+func PrintError(cmd *cobra.Command, format string, err error) {
+	if format != "json" {
+		cmd.PrintErrln(cmd.ErrPrefix(), err.Error())
+		return
+	}
+
+	exitCode := clierr.ExitCode(err)
+	body := errorBody{
+		Code:     clierr.ExitCodeName(exitCode),
+		ExitCode: exitCode,
+		Message:  err.Error(),
+	}
+
+	if cliErr, ok := clierr.AsError(err); ok {
+		body.Status = cliErr.StatusCode
+		body.Errors = cliErr.Details
+		body.RequestId = cliErr.RequestId
+	}
+
+	bytes, marshalErr := json.MarshalIndent(errorDocument{Error: body}, "", "\t")
+	if marshalErr != nil {
+		cmd.PrintErrln(cmd.ErrPrefix(), err.Error())
+		return
+	}
+	cmd.PrintErrln(string(bytes))
+}
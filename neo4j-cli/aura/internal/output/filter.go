@@ -0,0 +1,148 @@
+package output
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Condition on the value of a key of the items of a list, from --filter or --name
+type filter struct {
+	key     string
+	pattern string
+	negated bool
+	glob    *regexp.Regexp
+}
+
+// Prints a list response, keeping only the items matching the filter flags of the command
+func PrintList(cmd *cobra.Command, cfg *clicfg.Config, body []byte, fields []string) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	filters, err := parseFilters(cmd)
+	if err != nil {
+		return err
+	}
+
+	values, err := api.ParseBody(body)
+	if err != nil {
+		return err
+	}
+	if len(filters) > 0 {
+		rows := []map[string]any{}
+		for _, row := range values.AsArray() {
+			if matchesFilters(row, filters) {
+				rows = append(rows, row)
+			}
+		}
+		values = api.NewResponseData(rows)
+	}
+
+	return PrintBodyMap(cmd, cfg, values, fields)
+}
+
+func parseFilters(cmd *cobra.Command) ([]filter, error) {
+	filters := []filter{}
+
+	expressions, _ := cmd.Flags().GetStringArray("filter")
+	for _, expression := range expressions {
+		if key, pattern, found := strings.Cut(expression, "!="); found && key != "" {
+			filters = append(filters, filter{key: key, pattern: pattern, negated: true})
+		} else if key, pattern, found := strings.Cut(expression, "="); found && key != "" {
+			filters = append(filters, filter{key: key, pattern: pattern})
+		} else {
+			return nil, clierr.NewUsageError("invalid filter %q, expected key=value or key!=value", expression)
+		}
+	}
+
+	if name := flagValue(cmd, "name"); name != "" {
+		filters = append(filters, filter{key: "name", pattern: name})
+	}
+
+	for i, f := range filters {
+		glob, err := compileGlob(f.pattern)
+		if err != nil {
+			return nil, clierr.NewUsageError("invalid pattern %q: %w", f.pattern, err)
+		}
+		filters[i].glob = glob
+	}
+
+	return filters, nil
+}
+
+// Items lacking the key of a filter only match when the filter is negated
+func matchesFilters(row map[string]any, filters []filter) bool {
+	for _, f := range filters {
+		value := lookup(row, f.key)
+		matched := false
+		if value != nil {
+			matched = f.glob.MatchString(formatCell(value))
+		}
+		if matched == f.negated {
+			return false
+		}
+	}
+	return true
+}
+
+// Compiles a pattern where * matches any characters, ? any single character and [...] one of a class of characters,
+// negated by a leading ! or ^, and \ escapes the next character. Unlike path.Match, wildcards also match /,
+// as values such as URLs are not paths.
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString(`^(?s:`)
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			expr.WriteString(`.*`)
+		case '?':
+			expr.WriteString(`.`)
+		case '\\':
+			if i++; i == len(pattern) {
+				return nil, errors.New("trailing escape")
+			}
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, errors.New("unterminated character class")
+			}
+			class := pattern[i+1 : i+1+end]
+			i += end + 1
+
+			expr.WriteByte('[')
+			if negated, found := strings.CutPrefix(class, "!"); found {
+				expr.WriteByte('^')
+				class = negated
+			} else if negated, found := strings.CutPrefix(class, "^"); found {
+				expr.WriteByte('^')
+				class = negated
+			}
+			if class == "" {
+				return nil, errors.New("empty character class")
+			}
+			// Ranges such as a-z are kept, every other character is taken literally
+			for _, r := range class {
+				if r == '-' {
+					expr.WriteRune(r)
+				} else {
+					expr.WriteString(regexp.QuoteMeta(string(r)))
+				}
+			}
+			expr.WriteByte(']')
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	expr.WriteString(`)$`)
+	return regexp.Compile(expr.String())
+}
@@ -1,19 +1,34 @@
 package output
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
-	"reflect"
+	"fmt"
+	"strconv"
 
-	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
 )
 
-func PrintBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseData, fields []string) {
+func PrintBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseData, fields []string) error {
 	outputType := cfg.Aura.Output()
 
+	values = sortValues(cmd, values)
+	fields = selectColumns(cmd, values, fields)
+
+	if query := flagValue(cmd, "query"); query != "" {
+		result := applyQuery(values, query)
+		if outputType == "template" {
+			return printTemplate(cmd, cfg, result)
+		}
+		printQueryResult(cmd, outputType, result, fields)
+		return nil
+	}
+
 	switch output := outputType; output {
 	case "json":
 		bytes, err := json.MarshalIndent(values, "", "\t")
@@ -21,51 +36,98 @@ func PrintBodyMap(cmd *cobra.Command, cfg *clicfg.Config, values api.ResponseDat
 			panic(err)
 		}
 		cmd.Println(string(bytes))
+	case "yaml":
+		cmd.Print(marshalYaml(values))
 	case "table", "default":
 		printTable(cmd, values, fields)
+	case "csv":
+		printDelimited(cmd, values, fields, ',')
+	case "tsv":
+		printDelimited(cmd, values, fields, '\t')
+	case "template":
+		return printTemplate(cmd, cfg, values.AsArray())
 	default:
 		// This is in case the value is unknown
 		cmd.Println(values)
 	}
+	return nil
+}
+
+// Map keys are sorted by the encoder, keeping the output stable and diffable
+func marshalYaml(values any) string {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(values); err != nil {
+		panic(err)
+	}
+	return buf.String()
 }
 
-func PrintBody(cmd *cobra.Command, cfg *clicfg.Config, body []byte, fields []string) {
+func PrintBody(cmd *cobra.Command, cfg *clicfg.Config, body []byte, fields []string) error {
 	if len(body) == 0 {
-		return
+		return nil
+	}
+	values, err := api.ParseBody(body)
+	if err != nil {
+		return err
 	}
-	values := api.ParseBody(body)
 
-	PrintBodyMap(cmd, cfg, values, fields)
+	return PrintBodyMap(cmd, cfg, values, fields)
 }
 
 func printTable(cmd *cobra.Command, responseData api.ResponseData, fields []string) {
-	t := table.NewWriter()
+	cmd.Println(renderTable(responseData.AsArray(), fields, !noHeaders(cmd)))
+}
 
-	header := table.Row{}
-	for _, f := range fields {
-		header = append(header, f)
-	}
+// Prints one line per value, quoting cells containing the separator, quotes or line breaks
+func printDelimited(cmd *cobra.Command, responseData api.ResponseData, fields []string, separator rune) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Comma = separator
 
-	t.AppendHeader(header)
+	if !noHeaders(cmd) {
+		if err := w.Write(fields); err != nil {
+			panic(err)
+		}
+	}
 	for _, v := range responseData.AsArray() {
-		row := table.Row{}
-		for _, f := range fields {
-			formattedValue := v[f]
-
-			if v[f] == nil {
-				formattedValue = ""
-			}
+		record := make([]string, len(fields))
+		for i, f := range fields {
+			record[i] = formatCell(lookup(v, f))
+		}
+		if err := w.Write(record); err != nil {
+			panic(err)
+		}
+	}
 
-			if reflect.TypeOf(formattedValue).Kind() == reflect.Slice {
-				marshaledSlice, _ := json.MarshalIndent(formattedValue, "", "  ")
-				formattedValue = string(marshaledSlice)
-			}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		panic(err)
+	}
+	cmd.Print(buf.String())
+}
 
-			row = append(row, formattedValue)
+// Formats a value on a single line, nested values being written as compact JSON
+func formatCell(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []any, map[string]any:
+		marshaled, err := json.Marshal(v)
+		if err != nil {
+			panic(err)
 		}
-		t.AppendRow(row)
+		return string(marshaled)
+	default:
+		return fmt.Sprint(v)
 	}
+}
 
-	t.SetStyle(table.StyleLight)
-	cmd.Println(t.Render())
+func noHeaders(cmd *cobra.Command) bool {
+	return flagValue(cmd, "no-headers") == "true"
 }
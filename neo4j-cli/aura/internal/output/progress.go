@@ -0,0 +1,146 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+const spinnerInterval = 100 * time.Millisecond
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+type progressEvent struct {
+	Event          string `json:"event"`
+	Path           string `json:"path"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	ElapsedSeconds int    `json:"elapsed_seconds"`
+}
+
+// Reports the progress of awaited operations on stderr, as text lines or as one JSON event per line
+type pollReporter struct {
+	cmd *cobra.Command
+	cfg *clicfg.Config
+
+	mu      sync.Mutex
+	path    string
+	status  string
+	start   time.Time
+	spinner bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func NewPollReporter(cmd *cobra.Command, cfg *clicfg.Config) api.PollReporter {
+	return &pollReporter{cmd: cmd, cfg: cfg}
+}
+
+func (r *pollReporter) Start(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.path = path
+	r.status = ""
+	r.start = time.Now()
+
+	// Animating only makes sense when a person is watching the terminal
+	r.spinner = r.cfg.Aura.Output() != "json" && isTerminal(r.cmd.OutOrStdout()) && isTerminal(r.cmd.ErrOrStderr())
+	if r.spinner {
+		r.stop = make(chan struct{})
+		r.done = make(chan struct{})
+		go r.spin()
+	}
+}
+
+func (r *pollReporter) Transition(previous string, status string, elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status = status
+	w := r.cmd.ErrOrStderr()
+
+	if r.cfg.Aura.Output() == "json" {
+		bytes, err := json.Marshal(progressEvent{
+			Event:          "status",
+			Path:           r.path,
+			Status:         status,
+			PreviousStatus: previous,
+			ElapsedSeconds: int(elapsed.Seconds()),
+		})
+		if err == nil {
+			fmt.Fprintln(w, string(bytes))
+		}
+		return
+	}
+
+	if r.spinner {
+		clearLine(w)
+	}
+	if previous == "" {
+		fmt.Fprintf(w, "Status: %s (%s elapsed)\n", status, elapsed.Round(time.Second))
+	} else {
+		fmt.Fprintf(w, "Status: %s -> %s (%s elapsed)\n", previous, status, elapsed.Round(time.Second))
+	}
+}
+
+func (r *pollReporter) Stop() {
+	if !r.spinner {
+		return
+	}
+
+	close(r.stop)
+	<-r.done
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clearLine(r.cmd.ErrOrStderr())
+}
+
+func (r *pollReporter) spin() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			status := r.status
+			if status == "" {
+				status = "waiting"
+			}
+			w := r.cmd.ErrOrStderr()
+			clearLine(w)
+			fmt.Fprintf(w, "%s %s (%s elapsed)", spinnerFrames[frame%len(spinnerFrames)], status, time.Since(r.start).Round(time.Second))
+			r.mu.Unlock()
+		}
+	}
+}
+
+func clearLine(w io.Writer) {
+	fmt.Fprint(w, "\r\033[K")
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
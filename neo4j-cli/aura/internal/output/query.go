@@ -0,0 +1,109 @@
+package output
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+)
+
+// Applies a gjson path to the response, returning nil when nothing matches
+func applyQuery(values api.ResponseData, query string) any {
+	body, err := json.Marshal(values)
+	if err != nil {
+		panic(err)
+	}
+	return gjson.GetBytes(body, query).Value()
+}
+
+// Prints the result of a query, which can be any JSON value rather than a response.
+// Objects are printed as rows in tabular formats, other values one per line.
+func printQueryResult(cmd *cobra.Command, outputType string, result any, fields []string) {
+	switch outputType {
+	case "json":
+		bytes, err := json.MarshalIndent(result, "", "\t")
+		if err != nil {
+			panic(err)
+		}
+		cmd.Println(string(bytes))
+	case "yaml":
+		cmd.Print(marshalYaml(result))
+	default:
+		if rows, ok := asRows(result); ok {
+			values := api.NewResponseData(rows)
+			fields = queryResultFields(rows, fields)
+			switch outputType {
+			case "csv":
+				printDelimited(cmd, values, fields, ',')
+			case "tsv":
+				printDelimited(cmd, values, fields, '\t')
+			default:
+				printTable(cmd, values, fields)
+			}
+			return
+		}
+
+		lines, ok := result.([]any)
+		if !ok {
+			lines = []any{result}
+		}
+		for _, line := range lines {
+			if line != nil {
+				cmd.Println(formatCell(line))
+			}
+		}
+	}
+}
+
+// Returns the result as rows when it is an object or a non-empty array of objects
+func asRows(result any) ([]map[string]any, bool) {
+	switch v := result.(type) {
+	case map[string]any:
+		return []map[string]any{v}, true
+	case []any:
+		if len(v) == 0 {
+			return nil, false
+		}
+		rows := make([]map[string]any, len(v))
+		for i, item := range v {
+			row, ok := item.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			rows[i] = row
+		}
+		return rows, true
+	default:
+		return nil, false
+	}
+}
+
+// Keeps the fields of the command found in the rows, falling back to all their keys when none is found
+func queryResultFields(rows []map[string]any, fields []string) []string {
+	found := []string{}
+	keys := []string{}
+	for _, row := range rows {
+		for key := range row {
+			if slices.Contains(fields, key) && !slices.Contains(found, key) {
+				found = append(found, key)
+			}
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	if len(found) > 0 {
+		// Keeps the order of the fields of the command
+		return slices.DeleteFunc(slices.Clone(fields), func(field string) bool {
+			return !slices.Contains(found, field)
+		})
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,105 @@
+package output
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// Renders rows as a table, nested objects being flattened into dotted columns
+// and lists of objects rendered as tables within their cell
+func renderTable(rows []map[string]any, fields []string, headers bool) string {
+	columns := flattenColumns(rows, fields)
+
+	t := table.NewWriter()
+
+	if headers {
+		header := table.Row{}
+		for _, column := range columns {
+			header = append(header, column)
+		}
+		t.AppendHeader(header)
+	}
+	for _, v := range rows {
+		row := table.Row{}
+		for _, column := range columns {
+			row = append(row, formatTableCell(lookup(v, column)))
+		}
+		t.AppendRow(row)
+	}
+
+	t.SetStyle(table.StyleLight)
+	return t.Render()
+}
+
+// Replaces each field holding objects with a dotted column per key of these objects
+func flattenColumns(rows []map[string]any, fields []string) []string {
+	columns := []string{}
+	for _, field := range fields {
+		keys := []string{}
+		for _, row := range rows {
+			if nested, ok := lookup(row, field).(map[string]any); ok {
+				for key := range nested {
+					if !slices.Contains(keys, key) {
+						keys = append(keys, key)
+					}
+				}
+			}
+		}
+
+		if len(keys) == 0 {
+			columns = append(columns, field)
+			continue
+		}
+
+		sort.Strings(keys)
+		nestedFields := make([]string, len(keys))
+		for i, key := range keys {
+			nestedFields[i] = field + "." + key
+		}
+		columns = append(columns, flattenColumns(rows, nestedFields)...)
+	}
+	return columns
+}
+
+// Returns the value at a dotted path such as security.cors_policy, nil when not found
+func lookup(row map[string]any, path string) any {
+	var value any = row
+	for _, key := range strings.Split(path, ".") {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = nested[key]
+	}
+	return value
+}
+
+// Formats lists of objects as tables and other lists with one value per line
+func formatTableCell(value any) string {
+	values, ok := value.([]any)
+	if !ok {
+		return formatCell(value)
+	}
+
+	if rows, ok := asRows(value); ok {
+		keys := []string{}
+		for _, row := range rows {
+			for key := range row {
+				if !slices.Contains(keys, key) {
+					keys = append(keys, key)
+				}
+			}
+		}
+		sort.Strings(keys)
+		return renderTable(rows, keys, true)
+	}
+
+	lines := make([]string, len(values))
+	for i, v := range values {
+		lines[i] = formatCell(v)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,108 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+)
+
+// Functions available in templates, arguments are ordered so they read well in pipelines such as {{.name | pad 20}}
+var templateFuncs = template.FuncMap{
+	"json": func(value any) (string, error) {
+		marshaled, err := json.Marshal(value)
+		return string(marshaled), err
+	},
+	"pad": func(width int, value any) string {
+		return fmt.Sprintf("%-*s", width, formatCell(value))
+	},
+	"padLeft": func(width int, value any) string {
+		return fmt.Sprintf("%*s", width, formatCell(value))
+	},
+	"formatTime": func(layout string, value any) (string, error) {
+		text := formatCell(value)
+		if text == "" {
+			return "", nil
+		}
+		parsed, err := time.Parse(time.RFC3339, text)
+		if err != nil {
+			return "", err
+		}
+		return parsed.Format(layout), nil
+	},
+	"join": func(separator string, value any) string {
+		values, ok := value.([]any)
+		if !ok {
+			return formatCell(value)
+		}
+		cells := make([]string, len(values))
+		for i, v := range values {
+			cells[i] = formatCell(v)
+		}
+		return strings.Join(cells, separator)
+	},
+}
+
+// Checks the template flags before any request is made, so a mistake in the template does not hide the result of an operation
+func ValidateTemplate(cmd *cobra.Command, cfg *clicfg.Config) error {
+	if cfg.Aura.Output() != "template" {
+		if flagValue(cmd, "template") != "" || flagValue(cmd, "template-file") != "" {
+			return clierr.NewUsageError("--template and --template-file require the template output format")
+		}
+		return nil
+	}
+
+	_, err := parseTemplate(cmd, cfg)
+	return err
+}
+
+func parseTemplate(cmd *cobra.Command, cfg *clicfg.Config) (*template.Template, error) {
+	text := flagValue(cmd, "template")
+	if path := flagValue(cmd, "template-file"); path != "" {
+		data, err := afero.ReadFile(cfg.Aura.Fs(), path)
+		if err != nil {
+			return nil, clierr.NewUsageError("cannot read template file: %w", err)
+		}
+		text = string(data)
+	}
+	if text == "" {
+		return nil, clierr.NewUsageError("the template output format requires --template or --template-file")
+	}
+
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, clierr.NewUsageError("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// Renders the template over the items of the response or the result of the query, only printing once it fully succeeded
+func printTemplate(cmd *cobra.Command, cfg *clicfg.Config, data any) error {
+	tmpl, err := parseTemplate(cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return clierr.NewUsageError("cannot render template: %w", err)
+	}
+	cmd.Print(buf.String())
+	return nil
+}
+
+func flagValue(cmd *cobra.Command, name string) string {
+	flag := cmd.Flag(name)
+	if flag == nil {
+		return ""
+	}
+	return flag.Value.String()
+}
@@ -0,0 +1,17 @@
+package cache
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local cache of Aura API responses",
+	}
+
+	cmd.AddCommand(NewClearCmd(cfg))
+
+	return cmd
+}
@@ -0,0 +1,143 @@
+package cache_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+const instanceResponse = `{
+	"data": {
+		"id": "2f49c2b3",
+		"name": "Production",
+		"status": "running"
+	}
+}`
+
+func TestGetInstanceFromCache(t *testing.T) {
+	tests := map[string]struct {
+		ttl              string
+		flags            string
+		expectedRequests int
+	}{
+		"cached": {
+			ttl:              "1m",
+			expectedRequests: 1,
+		},
+		"disabled by default": {
+			ttl:              "0s",
+			expectedRequests: 2,
+		},
+		"no cache flag": {
+			ttl:              "1m",
+			flags:            " --no-cache",
+			expectedRequests: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.SetConfigValue("aura.cache.ttl", tt.ttl)
+			helper.KeepFs()
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, instanceResponse).AddResponse(http.StatusOK, instanceResponse)
+
+			helper.ExecuteCommand("instance get 2f49c2b3" + tt.flags)
+			helper.ExecuteCommand("instance get 2f49c2b3" + tt.flags)
+
+			mockHandler.AssertCalledTimes(tt.expectedRequests)
+			helper.AssertExitCode(0)
+		})
+	}
+}
+
+func TestCacheInvalidatedByChange(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.cache.ttl", "1m")
+	helper.KeepFs()
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, instanceResponse).AddResponse(http.StatusOK, instanceResponse)
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`).AddResponse(http.StatusOK, `{"data": []}`)
+	otherMock := helper.NewRequestHandlerMock("GET /v1/instances/432392ae", http.StatusOK, instanceResponse)
+	helper.NewRequestHandlerMock("POST /v1/instances/2f49c2b3/pause", http.StatusAccepted, instanceResponse)
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+	helper.ExecuteCommand("instance list")
+	helper.ExecuteCommand("instance get 432392ae")
+	helper.ExecuteCommand("instance pause 2f49c2b3")
+	helper.ExecuteCommand("instance get 2f49c2b3")
+	helper.ExecuteCommand("instance list")
+	helper.ExecuteCommand("instance get 432392ae")
+
+	getMock.AssertCalledTimes(2)
+	listMock.AssertCalledTimes(2)
+	otherMock.AssertCalledTimes(1)
+}
+
+func TestClearCache(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.cache.ttl", "1m")
+	helper.KeepFs()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, instanceResponse).AddResponse(http.StatusOK, instanceResponse)
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+	helper.ExecuteCommand("cache clear")
+	helper.AssertExitCode(0)
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	mockHandler.AssertCalledTimes(2)
+}
+
+func TestClearEmptyCache(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("cache clear")
+
+	helper.AssertExitCode(0)
+	helper.AssertOut("")
+}
+
+func TestWaitIgnoresCache(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.cache.ttl", "1m")
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "creating"}}`).AddResponse(http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running"}}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3")
+
+	mockHandler.AssertCalledTimes(2)
+	helper.AssertOut("Instance Status: running")
+}
+
+func TestCacheSeparatesAccountsOfEnvironmentCredentials(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.cache.ttl", "1m")
+	helper.KeepFs()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, instanceResponse).AddResponse(http.StatusOK, instanceResponse)
+
+	t.Setenv("AURA_ACCESS_TOKEN", "first-token")
+	helper.ExecuteCommand("instance get 2f49c2b3")
+	t.Setenv("AURA_ACCESS_TOKEN", "second-token")
+	helper.ExecuteCommand("instance get 2f49c2b3")
+	t.Setenv("AURA_ACCESS_TOKEN", "first-token")
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	mockHandler.AssertCalledTimes(2)
+	mockHandler.AssertCalledWithHeader("Authorization", "Bearer second-token")
+	helper.AssertExitCode(0)
+}
@@ -0,0 +1,21 @@
+package cache
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/spf13/cobra"
+)
+
+func NewClearCmd(cfg *clicfg.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Short: "Removes every cached response",
+		Long: `Removes every cached response of the Aura API.
+
+Responses to GET requests are only cached when the cache.ttl config is set to a non zero duration.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return api.ClearCache(cfg)
+		},
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/config/profile"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +15,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewGetCmd(cfg))
 	cmd.AddCommand(NewListCmd(cfg))
 	cmd.AddCommand(NewSetCmd(cfg))
+	cmd.AddCommand(profile.NewCmd(cfg))
 
 	return cmd
 }
@@ -3,6 +3,7 @@ package config_test
 import (
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -38,3 +39,42 @@ func TestGetConfigBetaEnabled(t *testing.T) {
 
 	helper.AssertOut("true")
 }
+
+func TestGetConfigFromEnvironment(t *testing.T) {
+	tests := map[string]struct {
+		key      string
+		variable string
+		value    string
+	}{
+		"default tenant": {key: "default-tenant", variable: "AURA_DEFAULT_TENANT", value: "env-tenant"},
+		"output":         {key: "output", variable: "AURA_OUTPUT", value: "yaml"},
+		"beta enabled":   {key: "beta-enabled", variable: "AURA_BETA_ENABLED", value: "true"},
+		"polling":        {key: "polling.max-retries", variable: "AURA_POLLING_MAX_RETRIES", value: "12"},
+		"columns":        {key: "columns.customer-managed-key", variable: "AURA_COLUMNS_CUSTOMER_MANAGED_KEY", value: "id,name"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			t.Setenv(tt.variable, tt.value)
+
+			helper.ExecuteCommand("config get " + tt.key)
+
+			helper.AssertOut(tt.value)
+		})
+	}
+}
+
+func TestGetConfigWithInvalidEnvironmentVariable(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_POLLING_INTERVAL", "soon")
+
+	helper.ExecuteCommand("config get polling.interval")
+
+	helper.AssertErrMessage("invalid environment variable AURA_POLLING_INTERVAL: invalid value specified for polling.interval: soon, must be a non-negative integer")
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
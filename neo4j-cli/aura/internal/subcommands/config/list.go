@@ -6,12 +6,25 @@ import (
 )
 
 func NewListCmd(cfg *clicfg.Config) *cobra.Command {
-	return &cobra.Command{
+	var showOrigin bool
+
+	const showOriginFlag = "show-origin"
+
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Lists the current configuration of the Aura CLI subcommand",
 		Args:  cobra.NoArgs,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if showOrigin {
+				return cfg.Aura.PrintWithOrigin(cmd.OutOrStdout())
+			}
+
 			cfg.Aura.Print(cmd)
+			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&showOrigin, showOriginFlag, false, "Lists each effective value along with where it comes from: flag, env, profile, file or default")
+
+	return cmd
 }
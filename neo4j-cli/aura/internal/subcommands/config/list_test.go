@@ -6,6 +6,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 )
 
 func TestListConfig(t *testing.T) {
@@ -16,5 +18,48 @@ func TestListConfig(t *testing.T) {
 
 	helper.ExecuteCommand("config list")
 
-	helper.AssertOutJson(fmt.Sprintf(`{"auth-url": "%s","base-url": "%s","beta-enabled": false,"output": "default"}`, clicfg.DefaultAuraAuthUrl, clicfg.DefaultAuraBaseUrl))
+	helper.AssertOutJson(fmt.Sprintf(`{"auth-url": "%s","await-timeout": "0s","base-url": "%s","beta-enabled": false,"cache": {"ttl": "%s"},"output": "default","polling": {"backoff": false,"interval": %d,"max-retries": %d},"retry": {"max-backoff": %d,"max-retries": %d,"non-idempotent": false},"timeout": "%s"}`, clicfg.DefaultAuraAuthUrl, clicfg.DefaultAuraBaseUrl, clicfg.DefaultAuraCacheTTL, clicfg.DefaultAuraPollingInterval, clicfg.DefaultAuraPollingMaxRetries, clicfg.DefaultAuraRetryMaxBackoff, clicfg.DefaultAuraRetryMaxRetries, clicfg.DefaultAuraTimeout))
+}
+
+func TestListConfigWithOrigin(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig(`{"aura": {"output": "table", "polling": {"interval": 5}}}`)
+	t.Setenv("AURA_OUTPUT", "yaml")
+	t.Setenv("AURA_DEFAULT_TENANT", "env-tenant")
+
+	helper.ExecuteCommand("config list --show-origin --timeout 5s")
+
+	helper.AssertOutJson(fmt.Sprintf(`[
+		{"key": "auth-url", "value": "%s", "origin": "default"},
+		{"key": "base-url", "value": "%s", "origin": "default"},
+		{"key": "default-tenant", "value": "env-tenant", "origin": "env"},
+		{"key": "output", "value": "yaml", "origin": "env"},
+		{"key": "beta-enabled", "value": false, "origin": "default"},
+		{"key": "retry.max-retries", "value": %d, "origin": "default"},
+		{"key": "retry.max-backoff", "value": %d, "origin": "default"},
+		{"key": "retry.non-idempotent", "value": false, "origin": "default"},
+		{"key": "timeout", "value": "5s", "origin": "flag"},
+		{"key": "polling.interval", "value": 5, "origin": "file"},
+		{"key": "polling.max-retries", "value": %d, "origin": "default"},
+		{"key": "polling.backoff", "value": false, "origin": "default"},
+		{"key": "await-timeout", "value": "0s", "origin": "default"},
+		{"key": "cache.ttl", "value": "%s", "origin": "default"}
+	]`, clicfg.DefaultAuraAuthUrl, clicfg.DefaultAuraBaseUrl, clicfg.DefaultAuraRetryMaxRetries, clicfg.DefaultAuraRetryMaxBackoff, clicfg.DefaultAuraPollingMaxRetries, clicfg.DefaultAuraCacheTTL))
+}
+
+func TestListConfigWithOriginOfProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig(`{"aura": {"output": "table", "beta-enabled": true}, "profiles": {"staging": {"aura": {"output": "yaml"}}}}`)
+
+	helper.ExecuteCommand("config list --show-origin --profile staging")
+
+	out := helper.PrintOut()
+	assert.Equal(t, "yaml", gjson.Get(out, `#(key=="output").value`).String())
+	assert.Equal(t, clicfg.OriginProfile, gjson.Get(out, `#(key=="output").origin`).String())
+	assert.Equal(t, clicfg.OriginFile, gjson.Get(out, `#(key=="beta-enabled").origin`).String())
+	assert.Equal(t, clicfg.OriginDefault, gjson.Get(out, `#(key=="timeout").origin`).String())
 }
@@ -0,0 +1,25 @@
+package profile
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
+	var credential string
+
+	const credentialFlag = "credential"
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Creates a profile, whose settings are then changed with `config set` while it is in use",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cfg.CreateProfile(args[0], credential)
+		},
+	}
+
+	cmd.Flags().StringVar(&credential, credentialFlag, "", "Name of the credential used with this profile, the default credential is used when not set")
+
+	return cmd
+}
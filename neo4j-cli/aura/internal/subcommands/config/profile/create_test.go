@@ -0,0 +1,80 @@
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestCreateProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config profile create staging --credential test-cred")
+
+	helper.AssertConfigValue("profiles.staging", `{"aura": {}, "credential": "test-cred"}`)
+}
+
+func TestCreateProfileWithoutCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config profile create staging")
+
+	helper.AssertConfigValue("profiles.staging", `{"aura": {}, "credential": ""}`)
+}
+
+func TestCreateProfileWithUnknownCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config profile create staging --credential unknown")
+
+	helper.AssertErrMessage("could not find credential with name unknown")
+	helper.AssertConfigValue("profiles", "")
+}
+
+func TestCreateProfileThatAlreadyExists(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{"output": "table"}})
+
+	helper.ExecuteCommand("config profile create staging")
+
+	helper.AssertErrMessage("already have profile with name staging")
+	helper.AssertConfigValue("profiles.staging.aura.output", "table")
+}
+
+func TestCreateProfileWithInvalidName(t *testing.T) {
+	tests := map[string]struct {
+		profile       string
+		expectedError string
+	}{
+		"reserved": {
+			profile:       "default",
+			expectedError: "profile name default is reserved for the top-level settings",
+		},
+		"uppercase": {
+			profile:       "Staging",
+			expectedError: "invalid profile name Staging, must only contain lowercase letters, digits, dashes and underscores",
+		},
+		"dotted": {
+			profile:       "eu.prod",
+			expectedError: "invalid profile name eu.prod, must only contain lowercase letters, digits, dashes and underscores",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.ExecuteCommand("config profile create " + tt.profile)
+
+			helper.AssertErrMessage(tt.expectedError)
+			helper.AssertExitCode(clierr.ExitCodeUsage)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package profile
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Deletes a profile, the top-level settings are used again if it was the current one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cfg.DeleteProfile(args[0])
+		},
+	}
+}
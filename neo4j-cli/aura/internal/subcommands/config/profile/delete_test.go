@@ -0,0 +1,43 @@
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestDeleteProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}})
+	helper.SetConfigValue("profiles.local", map[string]interface{}{"aura": map[string]string{}})
+	helper.SetConfigValue("profile", "local")
+
+	helper.ExecuteCommand("config profile delete staging")
+
+	helper.AssertConfigValue("profiles", `{"local": {"aura": {}}}`)
+	helper.AssertConfigValue("profile", "local")
+}
+
+func TestDeleteCurrentProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}})
+	helper.SetConfigValue("profile", "staging")
+
+	helper.ExecuteCommand("config profile delete staging")
+
+	helper.AssertConfigValue("profiles", "{}")
+	helper.AssertConfigValue("profile", "")
+}
+
+func TestDeleteProfileIfDoesNotExist(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config profile delete staging")
+
+	helper.AssertErrMessage("could not find profile with name staging to delete")
+}
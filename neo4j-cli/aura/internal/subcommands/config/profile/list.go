@@ -0,0 +1,17 @@
+package profile
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewListCmd(cfg *clicfg.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists the profiles and the one in use",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cfg.PrintProfiles(cmd.OutOrStdout())
+		},
+	}
+}
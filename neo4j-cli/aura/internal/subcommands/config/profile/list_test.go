@@ -0,0 +1,40 @@
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestListProfiles(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetCredentialsValue("aura.credentials.-1", map[string]string{"name": "staging-cred"})
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}, "credential": "staging-cred"})
+	helper.SetConfigValue("profiles.local", map[string]interface{}{"aura": map[string]string{}})
+	helper.SetConfigValue("profile", "staging")
+
+	helper.ExecuteCommand("config profile list")
+
+	helper.AssertOutJson(`[
+		{"name": "default", "credential": "test-cred", "current": false},
+		{"name": "staging", "credential": "staging-cred", "current": true},
+		{"name": "local", "credential": "", "current": false}
+	]`)
+}
+
+func TestListProfilesWithProfileFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.local", map[string]interface{}{"aura": map[string]string{}})
+	helper.SetConfigValue("profile", "local")
+
+	helper.ExecuteCommand("config profile list --profile default")
+
+	helper.AssertOutJson(`[
+		{"name": "default", "credential": "test-cred", "current": true},
+		{"name": "local", "credential": "", "current": false}
+	]`)
+}
@@ -0,0 +1,20 @@
+package profile
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage named sets of Aura settings and their credential, such as one per environment",
+	}
+
+	cmd.AddCommand(NewCreateCmd(cfg))
+	cmd.AddCommand(NewDeleteCmd(cfg))
+	cmd.AddCommand(NewListCmd(cfg))
+	cmd.AddCommand(NewUseCmd(cfg))
+
+	return cmd
+}
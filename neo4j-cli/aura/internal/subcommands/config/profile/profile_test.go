@@ -0,0 +1,144 @@
+package profile_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestProfileSettings(t *testing.T) {
+	tests := map[string]struct {
+		command        string
+		currentProfile string
+		env            string
+		expectedOut    string
+	}{
+		"top-level settings without profile": {
+			command:     "config get output",
+			expectedOut: "json",
+		},
+		"current profile": {
+			command:        "config get output",
+			currentProfile: "staging",
+			expectedOut:    "table",
+		},
+		"profile flag": {
+			command:        "config get output --profile local",
+			currentProfile: "staging",
+			expectedOut:    "yaml",
+		},
+		"environment variable": {
+			command:        "config get output",
+			currentProfile: "staging",
+			env:            "local",
+			expectedOut:    "yaml",
+		},
+		"profile flag over environment variable": {
+			command:     "config get output --profile staging",
+			env:         "local",
+			expectedOut: "table",
+		},
+		"default profile flag": {
+			command:        "config get output --profile default",
+			currentProfile: "staging",
+			expectedOut:    "json",
+		},
+		"unset setting of the profile": {
+			command:     "config get polling.max-retries --profile local",
+			expectedOut: "5",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{"output": "table"}})
+			helper.SetConfigValue("profiles.local", map[string]interface{}{"aura": map[string]string{"output": "yaml"}})
+			if tt.currentProfile != "" {
+				helper.SetConfigValue("profile", tt.currentProfile)
+			}
+			t.Setenv("AURA_PROFILE", tt.env)
+
+			helper.ExecuteCommand(tt.command)
+
+			helper.AssertOut(tt.expectedOut)
+		})
+	}
+}
+
+func TestSetConfigOfProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}})
+
+	helper.ExecuteCommand("config set output table --profile staging")
+
+	helper.AssertConfigValue("profiles.staging.aura.output", "table")
+	helper.AssertConfigValue("aura.output", "json")
+}
+
+func TestUnknownProfileFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config get output --profile staging")
+
+	helper.AssertErrMessage("could not find profile with name staging")
+}
+
+func TestProfileCredential(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}, "credential": "staging-cred"})
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --profile staging")
+
+	helper.AssertErrMessage("could not find credential with name staging-cred")
+	mockHandler.AssertCalledTimes(0)
+}
+
+func TestProfileEnablingBetaFeatures(t *testing.T) {
+	tests := map[string]struct {
+		profile       string
+		expectedCalls int
+		expectedErr   string
+	}{
+		"profile enabling beta features": {
+			profile:       "beta",
+			expectedCalls: 1,
+		},
+		"default profile": {
+			profile:     "default",
+			expectedErr: "the data-api commands are a beta feature, enable it with `config set beta-enabled true`",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.SetConfigValue("profiles.beta", map[string]interface{}{"aura": map[string]bool{"beta-enabled": true}, "credential": ""})
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1beta5/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{"data": []}`)
+
+			helper.ExecuteCommand("data-api graphql list --instance-id 2f49c2b3 --profile " + tt.profile)
+
+			mockHandler.AssertCalledTimes(tt.expectedCalls)
+			if tt.expectedErr != "" {
+				helper.AssertErrMessage(tt.expectedErr)
+				helper.AssertExitCode(clierr.ExitCodeUsage)
+			} else {
+				helper.AssertErr("")
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package profile
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/spf13/cobra"
+)
+
+func NewUseCmd(cfg *clicfg.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Sets the profile used when none is given by the --profile flag or the AURA_PROFILE environment variable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cfg.UseProfile(args[0])
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestUseProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}})
+
+	helper.ExecuteCommand("config profile use staging")
+
+	helper.AssertConfigValue("profile", "staging")
+}
+
+func TestUseDefaultProfile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}})
+	helper.SetConfigValue("profile", "staging")
+
+	helper.ExecuteCommand("config profile use default")
+
+	helper.AssertConfigValue("profile", "")
+}
+
+func TestUseProfileIfDoesNotExist(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("config profile use staging")
+
+	helper.AssertErrMessage("could not find profile with name staging")
+}
@@ -39,6 +39,28 @@ func TestSetConfigWithInvalidOutputValue(t *testing.T) {
 	helper.AssertErr("Error: invalid output value specified: invalid")
 }
 
+func TestSetYamlOutputConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set output yaml")
+
+	helper.AssertConfigValue("aura.output", "yaml")
+}
+
+func TestSetColumnsConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set columns.instance id,name,status,memory")
+
+	helper.AssertConfigValue("aura.columns.instance", "id,name,status,memory")
+}
+
 func TestSetBetaEnabledConfig(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -53,3 +75,80 @@ func TestSetBetaEnabledConfig(t *testing.T) {
 
 	helper.AssertConfigValue("aura.beta-enabled", "false")
 }
+
+func TestSetRetryConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set retry.max-retries 5")
+
+	helper.AssertConfigValue("aura.retry.max-retries", "5")
+}
+
+func TestSetRetryConfigWithInvalidValue(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set retry.max-backoff soon")
+
+	helper.AssertErr("Error: invalid value specified for retry.max-backoff: soon, must be a non-negative integer")
+}
+
+func TestSetTimeoutConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set timeout 2m")
+
+	helper.AssertConfigValue("aura.timeout", "2m")
+}
+
+func TestSetTimeoutConfigWithInvalidValue(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set timeout 30")
+
+	helper.AssertErr("Error: invalid value specified for timeout: 30, must be a non-negative duration such as 30s or 2m")
+}
+
+func TestSetPollingConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set polling.interval 5")
+
+	helper.AssertConfigValue("aura.polling.interval", "5")
+}
+
+func TestSetAwaitTimeoutConfig(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set await-timeout 45m")
+
+	helper.AssertConfigValue("aura.await-timeout", "45m")
+}
+
+func TestSetBetaEnabledConfigWithInvalidValue(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.OverwriteConfig("{}")
+
+	helper.ExecuteCommand("config set beta-enabled maybe")
+
+	helper.AssertErr("Error: invalid value specified for beta-enabled: maybe, must be true or false")
+}
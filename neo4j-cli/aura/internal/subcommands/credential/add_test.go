@@ -26,7 +26,7 @@ func TestAddCredentialIfAlreadyExists(t *testing.T) {
 
 	helper.ExecuteCommand("credential add --name test --client-id testclientid --client-secret testclientsecret")
 
-	helper.AssertErr("Error: already have credential with name test")
+	helper.AssertErrMessage("already have credential with name test")
 }
 func TestAddAditionalCredentials(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
@@ -40,3 +40,15 @@ func TestAddAditionalCredentials(t *testing.T) {
 	helper.AssertCredentialsValue("aura.credentials", `[{"name":"test","client-id":"testclientid","client-secret":"testclientsecret","access-token":"","token-expiry":0},{"name":"test-new","client-id":"testclientid2","client-secret":"testclientsecret2","access-token":"","token-expiry":0}]`)
 	helper.AssertCredentialsValue("aura.default-credential", "test")
 }
+
+func TestAddCredentialWithCredentialsFromEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_ACCESS_TOKEN", "env-token")
+
+	helper.ExecuteCommand("credential add --name test --client-id testclientid --client-secret testclientsecret")
+
+	helper.AssertErrMessage("credentials are given by the AURA_CLIENT_ID, AURA_CLIENT_SECRET or AURA_ACCESS_TOKEN environment variables, unset them to manage the stored credentials")
+	helper.AssertCredentialsValue("aura.credentials.#.name", `["test-cred"]`)
+}
@@ -15,6 +15,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewRemoveCmd(cfg))
 	cmd.AddCommand(NewUseCmd(cfg))
 	cmd.AddCommand(NewListCmd(cfg))
+	cmd.AddCommand(NewMigrateCmd(cfg))
 
 	return cmd
 }
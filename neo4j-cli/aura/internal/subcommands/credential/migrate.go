@@ -0,0 +1,48 @@
+package credential
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/spf13/cobra"
+)
+
+func NewMigrateCmd(cfg *clicfg.Config) *cobra.Command {
+	var to string
+
+	const toFlag = "to"
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Moves the client secrets and access tokens of the credentials to another secret backend",
+		Long: fmt.Sprintf(`Moves the client secrets and access tokens of the credentials to another secret backend.
+
+The %s backend keeps them in the credentials file, as done before secret backends existed.
+The %s backend keeps them in the freedesktop Secret Service on Linux, such as GNOME Keyring or KWallet, using the secret-tool command.
+The %s backend keeps them in a file encrypted with the passphrase set in the %s environment variable.`,
+			credentials.SecretBackendPlaintext, credentials.SecretBackendSecretService, credentials.SecretBackendEncryptedFile, credentials.PassphraseEnvironmentVariable),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.NoArgs(cmd, args); err != nil {
+				return err
+			}
+
+			if !credentials.IsValidSecretBackend(to) {
+				return clierr.NewUsageError("invalid secret backend %s, must be one of %s", to, strings.Join(credentials.SecretBackends, ", "))
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cfg.Credentials.MigrateSecrets(to)
+		},
+	}
+
+	cmd.Flags().StringVar(&to, toFlag, "", fmt.Sprintf("(required) Secret backend to move the secrets to, one of %s", strings.Join(credentials.SecretBackends, ", ")))
+	cmd.MarkFlagRequired(toFlag)
+	cmd.RegisterFlagCompletionFunc(toFlag, cobra.FixedCompletions(credentials.SecretBackends, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
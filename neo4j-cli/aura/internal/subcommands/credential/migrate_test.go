@@ -0,0 +1,114 @@
+package credential_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var secretsPath = filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "credentials.secrets.json")
+
+func TestMigrateCredentialsToEncryptedFileAndBack(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_CREDENTIALS_PASSPHRASE", "correct horse battery staple")
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]interface{}{{"name": "test", "client-id": "testclientid", "client-secret": "testclientsecret", "access-token": "testtoken", "token-expiry": 123}})
+	helper.SetCredentialsValue("aura.default-credential", "test")
+	helper.KeepFs()
+
+	helper.ExecuteCommand("credential migrate --to encrypted-file")
+
+	helper.AsssertOk()
+	helper.AssertCredentialsValue("secret-backend", "encrypted-file")
+	helper.AssertCredentialsValue("aura.credentials", `[{"name":"test","client-id":"testclientid","client-secret":"","access-token":"","token-expiry":123}]`)
+	assert.NotEmpty(t, helper.ReadFile(secretsPath))
+	assert.NotContains(t, helper.ReadFile(secretsPath), "testclientsecret")
+
+	helper.ExecuteCommand("credential migrate --to plaintext")
+
+	helper.AsssertOk()
+	helper.AssertCredentialsValue("secret-backend", "")
+	helper.AssertCredentialsValue("aura.credentials", `[{"name":"test","client-id":"testclientid","client-secret":"testclientsecret","access-token":"testtoken","token-expiry":123}]`)
+}
+
+func TestUseCredentialsInEncryptedFile(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_CREDENTIALS_PASSPHRASE", "correct horse battery staple")
+
+	helper.SetCredentialsValue("aura.credentials", []map[string]interface{}{{"name": "test", "client-id": "testclientid", "client-secret": "testclientsecret"}})
+	helper.SetCredentialsValue("aura.default-credential", "test")
+	helper.KeepFs()
+
+	helper.ExecuteCommand("credential migrate --to encrypted-file")
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3"}}`)
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	mockHandler.AssertCalledWithHeader("Authorization", "Bearer <token>")
+	helper.AssertOutJson(`{"data": {"id": "2f49c2b3"}}`)
+	// The token obtained is stored with the other secrets
+	helper.AssertCredentialsValue("aura.credentials.0.access-token", "")
+
+	helper.ExecuteCommand("credential migrate --to plaintext")
+
+	helper.AssertCredentialsValue("aura.credentials.0.client-secret", "testclientsecret")
+	helper.AssertCredentialsValue("aura.credentials.0.access-token", "<token>")
+}
+
+func TestMigrateCredentialsWithWrongPassphrase(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_CREDENTIALS_PASSPHRASE", "correct horse battery staple")
+	helper.KeepFs()
+
+	helper.ExecuteCommand("credential migrate --to encrypted-file")
+
+	t.Setenv("AURA_CREDENTIALS_PASSPHRASE", "wrong")
+
+	helper.ExecuteCommand("credential migrate --to plaintext")
+
+	helper.AssertErrMessage("cannot decrypt " + secretsPath + ", check the passphrase set in AURA_CREDENTIALS_PASSPHRASE")
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+	helper.AssertCredentialsValue("secret-backend", "encrypted-file")
+}
+
+func TestMigrateCredentialsWithoutPassphrase(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("credential migrate --to encrypted-file")
+
+	helper.AssertErrMessage("the encrypted-file backend requires a passphrase, set it in AURA_CREDENTIALS_PASSPHRASE")
+	helper.AssertCredentialsValue("secret-backend", "")
+	helper.AssertCredentialsValue("aura.credentials.0.access-token", "dsa")
+}
+
+func TestMigrateCredentialsToInvalidBackend(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("credential migrate --to keychain")
+
+	helper.AssertErrMessage("invalid secret backend keychain, must be one of plaintext, secret-service, encrypted-file")
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
+func TestMigrateCredentialsToCurrentBackend(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("credential migrate --to plaintext")
+
+	helper.AssertErrMessage("secrets are already stored in the plaintext backend")
+}
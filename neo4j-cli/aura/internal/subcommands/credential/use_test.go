@@ -1,8 +1,11 @@
 package credential_test
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -23,5 +26,114 @@ func TestUseCredentialIfDoesNotExist(t *testing.T) {
 
 	helper.ExecuteCommand("credential use test")
 
-	helper.AssertErr("Error: could not find credential with name test")
+	helper.AssertErrMessage("could not find credential with name test")
+}
+
+func TestSelectCredentialForOneCommand(t *testing.T) {
+	tests := map[string]struct {
+		flags         string
+		env           string
+		profile       bool
+		expectedToken string
+	}{
+		"default credential": {
+			expectedToken: "default-token",
+		},
+		"credential flag": {
+			flags:         " --credential other",
+			expectedToken: "other-token",
+		},
+		"environment variable": {
+			env:           "other",
+			expectedToken: "other-token",
+		},
+		"credential flag over environment variable": {
+			flags:         " --credential test",
+			env:           "other",
+			expectedToken: "default-token",
+		},
+		"credential of the profile": {
+			profile:       true,
+			expectedToken: "profile-token",
+		},
+		"environment variable over credential of the profile": {
+			env:           "other",
+			profile:       true,
+			expectedToken: "other-token",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			tokenExpiry := time.Now().Add(time.Hour).UnixMilli()
+			helper.SetCredentialsValue("aura.credentials", []map[string]interface{}{
+				{"name": "test", "access-token": "default-token", "token-expiry": tokenExpiry},
+				{"name": "other", "access-token": "other-token", "token-expiry": tokenExpiry},
+				{"name": "staging", "access-token": "profile-token", "token-expiry": tokenExpiry},
+			})
+			helper.SetCredentialsValue("aura.default-credential", "test")
+			if tt.profile {
+				helper.SetConfigValue("profiles.staging", map[string]interface{}{"aura": map[string]string{}, "credential": "staging"})
+				helper.SetConfigValue("profile", "staging")
+			}
+			t.Setenv("AURA_CREDENTIAL", tt.env)
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+			helper.ExecuteCommand("instance list" + tt.flags)
+
+			mockHandler.AssertCalledWithHeader("Authorization", "Bearer "+tt.expectedToken)
+			helper.AssertCredentialsValue("aura.default-credential", "test")
+		})
+	}
+}
+
+func TestSelectUnknownCredentialForOneCommand(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --credential unknown")
+
+	helper.AssertErrMessage("could not find credential with name unknown")
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+	mockHandler.AssertCalledTimes(0)
+}
+
+func TestSelectCredentialWithEnvironmentCredential(t *testing.T) {
+	tests := map[string]struct {
+		flags string
+		env   map[string]string
+	}{
+		"flag": {
+			flags: " --credential test-cred",
+			env:   map[string]string{"AURA_ACCESS_TOKEN": "env-token"},
+		},
+		"environment variable": {
+			env: map[string]string{"AURA_CREDENTIAL": "test-cred", "AURA_CLIENT_ID": "env-client-id", "AURA_CLIENT_SECRET": "env-client-secret"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+			helper.ExecuteCommand("instance list" + tt.flags)
+
+			helper.AssertErrMessage("cannot use credential test-cred as credentials are also given by the AURA_CLIENT_ID, AURA_CLIENT_SECRET or AURA_ACCESS_TOKEN environment variables, unset either of them")
+			helper.AssertExitCode(clierr.ExitCodeUsage)
+			mockHandler.AssertCalledTimes(0)
+		})
+	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
@@ -65,7 +66,7 @@ Once the key has a status of ready you can use it for creating new instances by
 			}
 
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, "/customer-managed-keys", &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, "/customer-managed-keys", &api.RequestConfig{
 				Method:   http.MethodPost,
 				PostBody: body,
 			})
@@ -74,16 +75,19 @@ Once the key has a status of ready you can use it for creating new instances by
 			}
 			// NOTE: Instance delete should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for customer managed key to be ready...")
 					var response api.CreateCMKResponse
 					if err := json.Unmarshal(resBody, &response); err != nil {
 						return err
 					}
 
-					pollResponse, err := api.PollCMK(cfg, response.Data.Id)
+					pollResponse, err := api.PollCMK(cmd.Context(), cfg, response.Data.Id, api.UntilStatus(api.CMKSuccessStatus...))
 					if err != nil {
 						return err
 					}
@@ -105,17 +109,21 @@ Once the key has a status of ready you can use it for creating new instances by
 	cmd.MarkFlagRequired(nameFlag)
 
 	cmd.Flags().Var(&instanceType, instanceTypeFlag, "(required) The type of the instance.")
+	cmd.RegisterFlagCompletionFunc(instanceTypeFlag, cobra.FixedCompletions(flags.InstanceTypeValues, cobra.ShellCompDirectiveNoFileComp))
 	cmd.MarkFlagRequired(instanceTypeFlag)
 
 	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "The Aura tenant/project ID")
+	cmd.RegisterFlagCompletionFunc(tenantIdFlag, completion.Tenants(cfg))
 
 	cmd.Flags().Var(&cloudProvider, cloudProviderFlag, "(required) The cloud provider hosting the instance.")
+	cmd.RegisterFlagCompletionFunc(cloudProviderFlag, cobra.FixedCompletions(flags.CloudProviderValues, cobra.ShellCompDirectiveNoFileComp))
 	cmd.MarkFlagRequired(cloudProviderFlag)
 
 	cmd.Flags().StringVar(&keyId, keyIdFlag, "", "(required) Encryption Key ARN")
 	cmd.MarkFlagRequired(keyIdFlag)
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created customer managed key is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
@@ -123,5 +123,5 @@ func TestCreateCustomerManagedKeysWithoutTenantID(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr("Error: required flag(s) \"tenant-id\" not set\n")
+	helper.AssertErrMessage("required flag(s) \"tenant-id\" not set\n")
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +36,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
 
-			return nil
+			return output.ValidateTemplate(cmd, cfg)
 		},
 	}
 
@@ -47,6 +48,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewDeleteCmd(cfg))
 	cmd.AddCommand(NewGetCmd(cfg))
 	cmd.AddCommand(NewListCmd(cfg))
+	cmd.AddCommand(NewWaitCmd(cfg))
 
 	return cmd
 }
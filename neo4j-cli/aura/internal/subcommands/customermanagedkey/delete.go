@@ -18,9 +18,14 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 Note that you can only delete a Key if it is not being used by any instances, otherwise you will get an error with the reason field set to encryption-key-is-active.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/customer-managed-keys/%s", args[0])
 			cmd.SilenceUsage = true
-			_, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			cmkId, err := api.ResolveCMKId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
+
+			_, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
 			if err != nil {
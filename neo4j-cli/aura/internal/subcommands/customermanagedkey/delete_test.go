@@ -40,7 +40,7 @@ func TestDeleteCustomerManagedKeyError(t *testing.T) {
 	}{
 		{
 			statusCode:    http.StatusBadRequest,
-			expectedError: fmt.Sprintf("Error: [Can not delete encryption key %s. The key is linked to an active instance.]", cmkId),
+			expectedError: fmt.Sprintf("[Can not delete encryption key %s. The key is linked to an active instance.]", cmkId),
 			returnBody: fmt.Sprintf(`{
 				"errors": [
 				  {
@@ -52,7 +52,7 @@ func TestDeleteCustomerManagedKeyError(t *testing.T) {
 		},
 		{
 			statusCode:    http.StatusNotFound,
-			expectedError: fmt.Sprintf("Error: [Encryption Key not found: %s]", cmkId),
+			expectedError: fmt.Sprintf("[Encryption Key not found: %s]", cmkId),
 			returnBody: fmt.Sprintf(`{
 				"errors": [
 				  {
@@ -77,7 +77,7 @@ func TestDeleteCustomerManagedKeyError(t *testing.T) {
 			mockHandler.AssertCalledWithMethod(http.MethodDelete)
 
 			helper.AssertOut("")
-			helper.AssertErr(testCase.expectedError)
+			helper.AssertErrMessage(testCase.expectedError)
 		})
 	}
 }
@@ -17,9 +17,14 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Long:  `This subcommand returns details about a specific Customer Managed Key.`,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/customer-managed-keys/%s", args[0])
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			cmkId, err := api.ResolveCMKId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/customer-managed-keys/%s", cmkId)
+
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
 			if err != nil {
@@ -27,7 +32,9 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"})
+				if err := output.PrintBody(cmd, cfg, resBody, output.DefaultColumns(cfg, "customer-managed-key", []string{"id", "name", "tenant_id", "status", "created", "cloud_provider", "key_id", "region", "type"})); err != nil {
+					return err
+				}
 
 			}
 
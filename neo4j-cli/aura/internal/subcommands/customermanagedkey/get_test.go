@@ -71,6 +71,35 @@ func TestGetCustomerManagedKeyNotFoundError(t *testing.T) {
 		mockHandler.AssertCalledTimes(1)
 		mockHandler.AssertCalledWithMethod(http.MethodGet)
 
-		helper.AssertErr(fmt.Sprintf("Error: [Encryption Key not found: %s]", cmkId))
+		helper.AssertErrMessage(fmt.Sprintf("[Encryption Key not found: %s]", cmkId))
 	}
 }
+
+func TestGetCustomerManagedKeyByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/customer-managed-keys", http.StatusOK, `{
+		"data": [
+			{"id": "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9", "name": "Instance01"},
+			{"id": "0a2b4c6d-8eb3-4a1c-92f6-e4ef0c7a6ed9", "name": "Instance02"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9", http.StatusOK, `{
+		"data": {
+			"id": "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9",
+			"name": "Instance01"
+		}
+	}`)
+
+	helper.ExecuteCommand("customer-managed-key get Instance01")
+
+	getMock.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9",
+			"name": "Instance01"
+		}
+	}`)
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -26,7 +28,7 @@ You can filter keys in a particular tenant using --tenant-id. If the tenant flag
 				queryParams["tenantId"] = tenantId
 			}
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
 			})
@@ -35,7 +37,9 @@ You can filter keys in a particular tenant using --tenant-id. If the tenant flag
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id"})
+				if err := output.PrintList(cmd, cfg, resBody, output.DefaultColumns(cfg, "customer-managed-key", []string{"id", "name", "tenant_id"})); err != nil {
+					return err
+				}
 
 			}
 
@@ -44,6 +48,10 @@ You can filter keys in a particular tenant using --tenant-id. If the tenant flag
 	}
 
 	cmd.Flags().StringVar(&tenantId, "tenant-id", "", "An optional Tenant ID to filter customer managed keys in a tenant")
+	cmd.RegisterFlagCompletionFunc("tenant-id", completion.Tenants(cfg))
+
+	flags.AddFilterFlags(cmd)
+	flags.AddNameFilterFlag(cmd)
 
 	return cmd
 }
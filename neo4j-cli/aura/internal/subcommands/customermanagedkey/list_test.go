@@ -102,6 +102,6 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 		helper.ExecuteCommand(fmt.Sprintf("%s list --output invalid", command))
 
-		helper.AssertErr("Error: invalid output value specified: invalid")
+		helper.AssertErrMessage("invalid output value specified: invalid")
 	}
 }
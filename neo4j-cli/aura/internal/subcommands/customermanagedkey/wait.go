@@ -0,0 +1,37 @@
+package customermanagedkey
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewWaitCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Waits for a customer managed key to reach a status",
+		Long:  `Waits until a customer managed key reaches the given status, ready by default, or until it is deleted when using --for-deletion.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			flags.BindPollingFlags(cmd, cfg)
+
+			cmkId, err := api.ResolveCMKId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			pollResponse, err := api.PollCMK(cmd.Context(), cfg, cmkId, flags.WaitUntil(cmd))
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("CMK Status:", pollResponse.Data.Status)
+			return nil
+		},
+	}
+
+	flags.AddWaitFlags(cmd, cfg, api.CMKStatusReady, api.CMKStatuses)
+	return cmd
+}
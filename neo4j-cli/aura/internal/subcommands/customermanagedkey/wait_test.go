@@ -0,0 +1,55 @@
+package customermanagedkey_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestWaitCustomerManagedKey(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	cmkId := "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9"
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/"+cmkId, http.StatusOK, `{
+			"data": {
+				"id": "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9",
+				"status": "pending"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9",
+				"status": "ready"
+			}
+		}`)
+
+	helper.ExecuteCommand("cmk wait " + cmkId)
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOut("CMK Status: ready")
+}
+
+func TestWaitCustomerManagedKeyForDeletion(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	cmkId := "8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9"
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/customer-managed-keys/"+cmkId, http.StatusNotFound, `{
+			"errors": [
+				{
+					"message": "Encryption Key not found: 8c764aed-8eb3-4a1c-92f6-e4ef0c7a6ed9",
+					"reason": "encryption-key-not-found"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand("customer-managed-key wait --for-deletion " + cmkId)
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertOut("CMK Status: deleted")
+}
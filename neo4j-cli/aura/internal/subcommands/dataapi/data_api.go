@@ -1,7 +1,12 @@
 package dataapi
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/dataapi/graphql"
@@ -11,12 +16,18 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "data-api",
 		Short: "Allows you to programmatically provision and manage your Data APIs",
+		// Shown once beta features are enabled in the top-level settings, the profile of the command is not known yet
+		Hidden: !cfg.Aura.AuraBetaEnabled(),
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if !cfg.Aura.AuraBetaEnabled() {
+				return clierr.NewUsageError("the data-api commands are a beta feature, enable it with `config set beta-enabled true`")
+			}
+
 			cfg.Aura.BindBaseUrl(cmd.Flags().Lookup("base-url"))
 			cfg.Aura.BindAuthUrl(cmd.Flags().Lookup("auth-url"))
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
 
-			return nil
+			return output.ValidateTemplate(cmd, cfg)
 		},
 	}
 
@@ -24,7 +35,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 	cmd.PersistentFlags().String("auth-url", "", "")
 	cmd.PersistentFlags().String("base-url", "", "")
-	cmd.PersistentFlags().String("output", "", "")
+	cmd.PersistentFlags().String("output", "", fmt.Sprintf("Format to print console output in, from a choice of [%s]", strings.Join(clicfg.ValidOutputValues[:], ", ")))
 
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
@@ -67,8 +68,12 @@ If you lose your API key, you will need to create a new Authentication provider.
 			}
 
 			cmd.SilenceUsage = true
+			instanceId, dataApiId, err := api.ResolveGraphQLDataApiId(cmd.Context(), cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers", instanceId, dataApiId)
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
 			})
@@ -85,11 +90,14 @@ If you lose your API key, you will need to create a new Authentication provider.
 					cmd.Println("###############################")
 				}
 
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "key", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "key", "url"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for GraphQL Data API to be ready...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, dataApiId, api.GraphQLDataApiStatusCreating)
+					pollResponse, err := api.PollGraphQLDataApi(cmd.Context(), cfg, instanceId, dataApiId, api.UntilStatus(api.GraphQLDataApiStatusReady))
 					if err != nil {
 						return err
 					}
@@ -102,6 +110,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 	}
 
 	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to create the GraphQL Data API for")
+	cmd.RegisterFlagCompletionFunc(instanceIdFlag, completion.Instances(cfg))
 	cmd.MarkFlagRequired(instanceIdFlag)
 
 	cmd.Flags().StringVar(&dataApiId, dataApiIdFlag, "", "(required) The ID of the GraphQL Data API to create the authentication provider for")
@@ -109,6 +118,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 
 	msgTypeFlag := fmt.Sprintf("(required) The type of the Authentication provider, one of '%s' or '%s'", api.GraphQLDataApiAuthProviderTypeApiKey, api.GraphQLDataApiAuthProviderTypeJwks)
 	cmd.Flags().Var(&_type, typeFlag, msgTypeFlag)
+	cmd.RegisterFlagCompletionFunc(typeFlag, cobra.FixedCompletions(flags.AuthProviderTypeValues, cobra.ShellCompDirectiveNoFileComp))
 	cmd.MarkFlagRequired(typeFlag)
 
 	cmd.Flags().StringVar(&name, nameFlag, "", "(required) The name of the Authentication provider")
@@ -120,6 +130,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 	cmd.Flags().StringVar(&url, urlFlag, "", msgUrlFlag)
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created Authentication provider is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
@@ -24,34 +24,34 @@ func TestCreateAuthProviderFlagsValidation(t *testing.T) {
 	}{
 		"missing all create flags": {
 			executedCommand: fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s", instanceId, dataApiId),
-			expectedError:   "Error: required flag(s) \"name\", \"type\" not set",
+			expectedError:   "required flag(s) \"name\", \"type\" not set",
 		},
 		"missing name flag": {
 			executedCommand: fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s --type api-key", instanceId, dataApiId),
-			expectedError:   "Error: required flag(s) \"name\" not set",
+			expectedError:   "required flag(s) \"name\" not set",
 		},
 		"missing type flag": {
 			executedCommand: fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s --name %s --disabled", instanceId, dataApiId, name),
-			expectedError:   "Error: required flag(s) \"type\" not set",
+			expectedError:   "required flag(s) \"type\" not set",
 		},
 		"non-existing type flag": {
 			executedCommand: fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s --name %s --type bla --disabled", instanceId, dataApiId, name),
-			expectedError:   `Error: invalid argument "bla" for "--type" flag: must be one of "api-key" or "jwks"`,
+			expectedError:   `invalid argument "bla" for "--type" flag: must be one of "api-key" or "jwks"`,
 		},
 		"missing url flag for jwks": {
 			executedCommand: fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s --name %s --type jwks --disabled", instanceId, dataApiId, name),
-			expectedError:   "Error: required flag(s) \"url\" not set",
+			expectedError:   "required flag(s) \"url\" not set",
 		},
 		"can not set url flag for api-key": {
 			executedCommand: fmt.Sprintf("data-api graphql auth-provider create --instance-id %s --data-api-id %s --name %s --type api-key --url http://test.com/abc", instanceId, dataApiId, name),
-			expectedError:   "Error: url flag can not be set for authentication provider type 'api-key'",
+			expectedError:   "url flag can not be set for authentication provider type 'api-key'",
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			helper.ExecuteCommand(tt.executedCommand)
-			helper.AssertErr(tt.expectedError)
+			helper.AssertErrMessage(tt.expectedError)
 		})
 	}
 }
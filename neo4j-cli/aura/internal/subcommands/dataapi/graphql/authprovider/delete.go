@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -23,9 +24,13 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			instanceId, dataApiId, err := api.ResolveGraphQLDataApiId(cmd.Context(), cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", instanceId, dataApiId, args[0])
 
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
 			if err != nil {
@@ -34,13 +39,16 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 
 			// NOTE: delete should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance to delete the Data API for")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().StringVar(&dataApiId, "data-api-id", "", "(required) The ID of the GraphQL Data API to delete the Authentication provider for")
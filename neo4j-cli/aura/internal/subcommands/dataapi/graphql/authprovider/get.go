@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -23,21 +24,28 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			instanceId, dataApiId, err := api.ResolveGraphQLDataApiId(cmd.Context(), cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/auth-providers/%s", instanceId, dataApiId, args[0])
 
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{Method: http.MethodGet})
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{Method: http.MethodGet})
 			if err != nil {
 				return err
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "type", "enabled", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, output.DefaultColumns(cfg, "auth-provider", []string{"id", "name", "type", "enabled", "url"})); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance the GraphQL Data API is connected to")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().StringVar(&dataApiId, "data-api-id", "", "(required) The ID of the GraphQL Data API to get the authentication provider of")
@@ -8,6 +8,8 @@ import (
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -37,7 +39,12 @@ Adding a new allowed origin to the CORS policy of a GraphQL Data API allows brow
 		RunE: func(cmd *cobra.Command, args []string) error {
 			newOrigin := args[0]
 
-			existingOrigins, err := getExistingOrigins(cfg, dataApiId, instanceId)
+			instanceId, dataApiId, err := api.ResolveGraphQLDataApiId(cmd.Context(), cfg, instanceId, dataApiId)
+			if err != nil {
+				return err
+			}
+
+			existingOrigins, err := getExistingOrigins(cmd.Context(), cfg, dataApiId, instanceId)
 			if err != nil {
 				return err
 			}
@@ -60,7 +67,7 @@ Adding a new allowed origin to the CORS policy of a GraphQL Data API allows brow
 				},
 			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, dataApiId)
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPatch,
 			})
@@ -71,10 +78,13 @@ Adding a new allowed origin to the CORS policy of a GraphQL Data API allows brow
 			// NOTE: Update should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
 				cmd.Printf("New allowed origins: [\"%s\"]\n", strings.Join(newOrigins, "\", \""))
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"}); err != nil {
+					return err
+				}
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for GraphQL Data API to be ready...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, dataApiId, api.GraphQLDataApiStatusUpdating)
+					pollResponse, err := api.PollGraphQLDataApi(cmd.Context(), cfg, instanceId, dataApiId, api.UntilStatus(api.GraphQLDataApiStatusReady))
 					if err != nil {
 						return err
 					}
@@ -87,12 +97,14 @@ Adding a new allowed origin to the CORS policy of a GraphQL Data API allows brow
 	}
 
 	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance the GraphQL Data API is connected to")
+	cmd.RegisterFlagCompletionFunc(instanceIdFlag, completion.Instances(cfg))
 	cmd.MarkFlagRequired(instanceIdFlag)
 
 	cmd.Flags().StringVar(&dataApiId, dataApiIdFlag, "", "(required) The ID of the GraphQL Data API to add the CORS allowed origin for")
 	cmd.MarkFlagRequired(dataApiIdFlag)
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until updated GraphQL Data API is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
@@ -5,6 +5,9 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -35,26 +38,26 @@ func TestAddAllowedOriginFlagsValidation(t *testing.T) {
 	}{
 		"missing all flags": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin add %s", allowedOrigin),
-			expectedError:   "Error: required flag(s) \"data-api-id\", \"instance-id\" not set",
+			expectedError:   "required flag(s) \"data-api-id\", \"instance-id\" not set",
 		},
 		"missing origin": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin add --data-api-id %s --instance-id %s", dataApiId, instanceId),
-			expectedError:   "Error: accepts 1 arg(s), received 0",
+			expectedError:   "accepts 1 arg(s), received 0",
 		},
 		"missing data api id flag": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin add %s --instance-id %s", allowedOrigin, instanceId),
-			expectedError:   "Error: required flag(s) \"data-api-id\" not set",
+			expectedError:   "required flag(s) \"data-api-id\" not set",
 		},
 		"missing instance id flag": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin add %s --data-api-id %s", allowedOrigin, dataApiId),
-			expectedError:   "Error: required flag(s) \"instance-id\" not set",
+			expectedError:   "required flag(s) \"instance-id\" not set",
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			helper.ExecuteCommand(tt.executedCommand)
-			helper.AssertErr(tt.expectedError)
+			helper.AssertErrMessage(tt.expectedError)
 		})
 	}
 }
@@ -172,7 +175,7 @@ func TestAddAllowedOriginWithDuplicateOrigin(t *testing.T) {
 	mockHandler.AssertCalledTimes(1)
 	mockHandler.AssertCalledWithMethod(http.MethodGet)
 
-	helper.AssertErr(fmt.Sprintf("Error: Origin \"%s\" already exists in allowed origins\n", allowedOrigin))
+	helper.AssertErrMessage(fmt.Sprintf("Origin \"%s\" already exists in allowed origins\n", allowedOrigin))
 }
 
 func TestAddAllowedOriginWithOutputTable(t *testing.T) {
@@ -214,3 +217,44 @@ func TestAddAllowedOriginWithOutputTable(t *testing.T) {
 
 	helper.AssertOut(expectedResponse)
 }
+
+func TestAddAllowedOriginWithUnexpectedGetResponse(t *testing.T) {
+	tests := map[string]struct {
+		statusCode       int
+		body             string
+		expectedMessage  string
+		expectedExitCode int
+	}{
+		"unexpected status code": {
+			statusCode:       http.StatusNoContent,
+			body:             "",
+			expectedMessage:  "unexpected status code 204 getting the GraphQL Data API",
+			expectedExitCode: clierr.ExitCodeUpstream,
+		},
+		"malformed body": {
+			statusCode:       http.StatusOK,
+			body:             `{"data": []}`,
+			expectedMessage:  "cannot parse the GraphQL Data API",
+			expectedExitCode: clierr.ExitCodeFatal,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.SetConfigValue("aura.beta-enabled", true)
+
+			mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1beta5/instances/%s/data-apis/graphql/%s", instanceId, dataApiId), tt.statusCode, tt.body)
+
+			helper.ExecuteCommand(fmt.Sprintf("data-api graphql cors-policy allowed-origin add %s --instance-id %s --data-api-id %s", allowedOrigin, instanceId, dataApiId))
+
+			mockHandler.AssertCalledTimes(1)
+			mockHandler.AssertCalledWithMethod(http.MethodGet)
+
+			assert.Contains(t, helper.PrintErr(), tt.expectedMessage)
+			helper.AssertExitCode(tt.expectedExitCode)
+		})
+	}
+}
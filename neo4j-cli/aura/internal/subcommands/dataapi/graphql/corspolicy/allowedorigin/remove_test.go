@@ -20,26 +20,26 @@ func TestRemoveAllowedOriginFlagsValidation(t *testing.T) {
 	}{
 		"missing all flags": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin remove %s", allowedOrigin),
-			expectedError:   "Error: required flag(s) \"data-api-id\", \"instance-id\" not set",
+			expectedError:   "required flag(s) \"data-api-id\", \"instance-id\" not set",
 		},
 		"missing origin": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin remove --data-api-id %s --instance-id %s", dataApiId, instanceId),
-			expectedError:   "Error: accepts 1 arg(s), received 0",
+			expectedError:   "accepts 1 arg(s), received 0",
 		},
 		"missing data api id flag": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin remove %s --instance-id %s", allowedOrigin, instanceId),
-			expectedError:   "Error: required flag(s) \"data-api-id\" not set",
+			expectedError:   "required flag(s) \"data-api-id\" not set",
 		},
 		"missing instance id flag": {
 			executedCommand: fmt.Sprintf("data-api graphql cors-policy allowed-origin remove %s --data-api-id %s", allowedOrigin, dataApiId),
-			expectedError:   "Error: required flag(s) \"instance-id\" not set",
+			expectedError:   "required flag(s) \"instance-id\" not set",
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			helper.ExecuteCommand(tt.executedCommand)
-			helper.AssertErr(tt.expectedError)
+			helper.AssertErrMessage(tt.expectedError)
 		})
 	}
 }
@@ -115,7 +115,7 @@ func TestRemoveAllowedOriginWithNoExistingOrigins(t *testing.T) {
 	mockHandler.AssertCalledTimes(1)
 	mockHandler.AssertCalledWithMethod(http.MethodGet)
 
-	helper.AssertErr(fmt.Sprintf("Error: Origin \"%s\" not found in allowed origins", allowedOrigin))
+	helper.AssertErrMessage(fmt.Sprintf("Origin \"%s\" not found in allowed origins", allowedOrigin))
 }
 
 func TestRemoveAllowedOriginLastAllowedOrigin(t *testing.T) {
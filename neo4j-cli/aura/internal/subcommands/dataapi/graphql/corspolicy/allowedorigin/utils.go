@@ -1,6 +1,7 @@
 package allowedorigin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,22 +28,24 @@ type CorsPolicyBody struct {
 	AllowedOrigins []string `json:"allowed_origins"`
 }
 
-func getExistingOrigins(cfg *clicfg.Config, dataApiId, instanceId string) ([]string, error) {
+func getExistingOrigins(ctx context.Context, cfg *clicfg.Config, dataApiId, instanceId string) ([]string, error) {
 	getPath := fmt.Sprintf("/instances/%s/data-apis/graphql/%s", instanceId, dataApiId)
-	getResBody, statusCode, err := api.MakeRequest(cfg, getPath, &api.RequestConfig{
-		Method: http.MethodGet,
+	// The origins are updated from this response, so it must not be a stale cached one
+	getResBody, statusCode, err := api.MakeRequest(ctx, cfg, getPath, &api.RequestConfig{
+		Method:  http.MethodGet,
+		NoCache: true,
 	})
 	if err != nil {
 		return nil, err
 	}
 	if statusCode != http.StatusOK {
-		panic(clierr.NewFatalError("unexpected status code %d running CLI with args %s, please report an issue in https://github.com/neo4j/cli", statusCode, os.Args[1:]))
+		return nil, clierr.NewUpstreamError("unexpected status code %d getting the GraphQL Data API", statusCode).WithStatusCode(statusCode)
 	}
 
 	var parsedGetResBody DetailedBody
 	err = json.Unmarshal(getResBody, &parsedGetResBody)
 	if err != nil {
-		panic(err)
+		return nil, clierr.NewFatalError("cannot parse the GraphQL Data API running CLI with args %s, please report an issue in https://github.com/neo4j/cli: %w", os.Args[1:], err)
 	}
 
 	return parsedGetResBody.Data.Security.CorsPolicy.AllowedOrigins, nil
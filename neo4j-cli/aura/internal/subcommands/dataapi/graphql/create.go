@@ -7,6 +7,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -67,8 +69,12 @@ If you lose your API key, you will need to create a new Authentication provider.
 			body["type_definitions"] = typeDefsForBody
 
 			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, instanceId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/data-apis/graphql", instanceId)
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
 			})
@@ -83,16 +89,19 @@ If you lose your API key, you will need to create a new Authentication provider.
 				cmd.Println("# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.")
 				cmd.Println("###############################")
 
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url", "authentication_providers"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url", "authentication_providers"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for GraphQL Data API to be ready...")
 					var response api.CreateGraphQLDataApiResponse
 					if err := json.Unmarshal(resBody, &response); err != nil {
 						return err
 					}
 
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, response.Data.Id, api.GraphQLDataApiStatusCreating)
+					pollResponse, err := api.PollGraphQLDataApi(cmd.Context(), cfg, instanceId, response.Data.Id, api.UntilStatus(api.GraphQLDataApiStatusReady))
 					if err != nil {
 						return err
 					}
@@ -105,6 +114,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 	}
 
 	cmd.Flags().StringVar(&instanceId, instanceIdFlag, "", "(required) The ID of the instance to create the GraphQL Data API for")
+	cmd.RegisterFlagCompletionFunc(instanceIdFlag, completion.Instances(cfg))
 	cmd.MarkFlagRequired(instanceIdFlag)
 
 	cmd.Flags().StringVar(&instanceUsername, instanceUsernameFlag, "", "(required) The username of the instance this GraphQL Data API will be connected to")
@@ -123,6 +133,7 @@ If you lose your API key, you will need to create a new Authentication provider.
 	cmd.MarkFlagsOneRequired(typeDefsFlag, typeDefsFileFlag)
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created GraphQL Data API is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
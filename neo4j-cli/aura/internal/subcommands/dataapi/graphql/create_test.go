@@ -29,42 +29,42 @@ func TestCreateGraphQLDataApiFlagsValidation(t *testing.T) {
 	}{
 		"missing almost all flags": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --type-definitions %s", instanceId, typeDefs),
-			expectedError:   "Error: required flag(s) \"instance-password\", \"instance-username\", \"name\" not set",
+			expectedError:   "required flag(s) \"instance-password\", \"instance-username\", \"name\" not set",
 		},
 		"missing any type defs flag": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-username %s --instance-password %s --name %s ", instanceId, instanceUsername, instancePassword, name),
-			expectedError:   "Error: at least one of the flags in the group [type-definitions type-definitions-file] is required",
+			expectedError:   "at least one of the flags in the group [type-definitions type-definitions-file] is required",
 		},
 		"only one type defs flag can be provided": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-username %s --instance-password %s --name %s --type-definitions %s --type-definitions-file %s", instanceId, instanceUsername, instancePassword, name, typeDefs, typeDefsFile),
-			expectedError:   "Error: if any flags in the group [type-definitions type-definitions-file] are set none of the others can be; [type-definitions type-definitions-file] were all set",
+			expectedError:   "if any flags in the group [type-definitions type-definitions-file] are set none of the others can be; [type-definitions type-definitions-file] were all set",
 		},
 		"missing instance password flag": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-username %s --name %s --type-definitions %s", instanceId, instanceUsername, name, typeDefs),
-			expectedError:   "Error: required flag(s) \"instance-password\" not set",
+			expectedError:   "required flag(s) \"instance-password\" not set",
 		},
 		"missing instance username flag": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-password %s --name %s --type-definitions %s", instanceId, instancePassword, name, typeDefs),
-			expectedError:   "Error: required flag(s) \"instance-username\" not set",
+			expectedError:   "required flag(s) \"instance-username\" not set",
 		},
 		"missing name flag": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-username %s --instance-password %s --type-definitions %s", instanceId, instanceUsername, instancePassword, typeDefs),
-			expectedError:   "Error: required flag(s) \"name\" not set",
+			expectedError:   "required flag(s) \"name\" not set",
 		},
 		"invalid base64 for type defs": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-username %s --instance-password %s --name %s --type-definitions %s", instanceId, instanceUsername, instancePassword, name, invalidBase64TypeDefs),
-			expectedError:   "Error: provided type definitions are not valid base64",
+			expectedError:   "provided type definitions are not valid base64",
 		},
 		"invalid type defs file": {
 			executedCommand: fmt.Sprintf("data-api graphql create --instance-id %s --instance-username %s --instance-password %s --name %s --type-definitions-file %s", instanceId, instanceUsername, instancePassword, name, invalidTypeDefsFile),
-			expectedError:   "Error: type definitions file '../invalid/typeDefs.graphql' does not exist",
+			expectedError:   "type definitions file '../invalid/typeDefs.graphql' does not exist",
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			helper.ExecuteCommand(tt.executedCommand)
-			helper.AssertErr(tt.expectedError)
+			helper.AssertErrMessage(tt.expectedError)
 		})
 	}
 }
@@ -117,19 +117,21 @@ func TestCreateGraphQLDataApiWithResponse(t *testing.T) {
 	expectedResponseTable := `###############################
 # It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.
 ###############################
-┌──────────┬───────────────┬──────────┬────────────────────────────────────────────────────────────────────────────────┬───────────────────────────────────────────────────┐
-│ ID       │ NAME          │ STATUS   │ URL                                                                            │ AUTHENTICATION_PROVIDERS                          │
-├──────────┼───────────────┼──────────┼────────────────────────────────────────────────────────────────────────────────┼───────────────────────────────────────────────────┤
-│ 2f49c2b3 │ my-data-api-1 │ creating │ https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql │ [                                                 │
-│          │               │          │                                                                                │   {                                               │
-│          │               │          │                                                                                │     "enabled": true,                              │
-│          │               │          │                                                                                │     "id": "1ad1b794-e40e-41f7-8e8c-5638130317ed", │
-│          │               │          │                                                                                │     "key": "ublHwKxm2ylsc1HlkuL8NAcMfZnEVP1g",    │
-│          │               │          │                                                                                │     "name": "default",                            │
-│          │               │          │                                                                                │     "type": "api-key"                             │
-│          │               │          │                                                                                │   }                                               │
-│          │               │          │                                                                                │ ]                                                 │
-└──────────┴───────────────┴──────────┴────────────────────────────────────────────────────────────────────────────────┴───────────────────────────────────────────────────┘
+┌──────────┬───────────────┬──────────┬────────────────────────────────────────────────────────────────────────────────┬───────────────────────────────────────────────────────────────────────────────────────────────────────────┐
+│ ID       │ NAME          │ STATUS   │ URL                                                                            │ AUTHENTICATION_PROVIDERS                                                                                  │
+├──────────┼───────────────┼──────────┼────────────────────────────────────────────────────────────────────────────────┼───────────────────────────────────────────────────────────────────────────────────────────────────────────┤
+│ 2f49c2b3 │ my-data-api-1 │ creating │ https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql │ ┌─────────┬──────────────────────────────────────┬──────────────────────────────────┬─────────┬─────────┐ │
+│          │               │          │                                                                                │ │ ENABLED │ ID                                   │ KEY                              │ NAME    │ TYPE    │ │
+│          │               │          │                                                                                │ ├─────────┼──────────────────────────────────────┼──────────────────────────────────┼─────────┼─────────┤ │
+│          │               │          │                                                                                │ │ true    │ 1ad1b794-e40e-41f7-8e8c-5638130317ed │ ublHwKxm2ylsc1HlkuL8NAcMfZnEVP1g │ default │ api-key │ │
+│          │               │          │                                                                                │ └─────────┴──────────────────────────────────────┴──────────────────────────────────┴─────────┴─────────┘ │
+└──────────┴───────────────┴──────────┴────────────────────────────────────────────────────────────────────────────────┴───────────────────────────────────────────────────────────────────────────────────────────────────────────┘
+	`
+	expectedResponseCsv := `###############################
+# It is important to store the created API key! If you lose your API key, you will need to create a new Authentication provider. This will not result in any loss of data.
+###############################
+id,name,status,url,authentication_providers
+2f49c2b3,my-data-api-1,creating,https://2f49c2b3.28be6e4d8d3e8360197cb6c1fa1d25d1.graphql.neo4j-dev.io/graphql,"[{""enabled"":true,""id"":""1ad1b794-e40e-41f7-8e8c-5638130317ed"",""key"":""ublHwKxm2ylsc1HlkuL8NAcMfZnEVP1g"",""name"":""default"",""type"":""api-key""}]"
 	`
 
 	tests := map[string]struct {
@@ -148,6 +150,11 @@ func TestCreateGraphQLDataApiWithResponse(t *testing.T) {
 			executeCommand:      fmt.Sprintf("data-api graphql create --output table --instance-id %s --instance-username %s --instance-password %s --name %s --type-definitions %s ", instanceId, instanceUsername, instancePassword, name, typeDefsEncoded),
 			expectedRequestBody: `{"aura_instance":{"password":"dfjglhssdopfrow","username":"neo4j"},"name":"my-data-api-1","security":{"authentication_providers":[{"enabled":true,"name":"default","type":"api-key"}]},"type_definitions":"dHlwZSBNb3ZpZSB7CiAgdGl0bGU6IFN0cmluZwkKfQ=="}`,
 			expectedResponse:    expectedResponseTable,
+		}, "create with default auth provider and output as csv": {
+			mockResponse:        mockResponse,
+			executeCommand:      fmt.Sprintf("data-api graphql create --output csv --instance-id %s --instance-username %s --instance-password %s --name %s --type-definitions %s ", instanceId, instanceUsername, instancePassword, name, typeDefsEncoded),
+			expectedRequestBody: `{"aura_instance":{"password":"dfjglhssdopfrow","username":"neo4j"},"name":"my-data-api-1","security":{"authentication_providers":[{"enabled":true,"name":"default","type":"api-key"}]},"type_definitions":"dHlwZSBNb3ZpZSB7CiAgdGl0bGU6IFN0cmluZwkKfQ=="}`,
+			expectedResponse:    expectedResponseCsv,
 		},
 	}
 
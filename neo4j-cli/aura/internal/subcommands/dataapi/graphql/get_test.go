@@ -48,6 +48,50 @@ func TestGetGraphQLDataApi(t *testing.T) {
 	}`)
 }
 
+func TestGetGraphQLDataApiWithTableOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	instanceId := "2f49c2b3"
+	dataApiId := "afdb4e9d"
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1beta5/instances/%s/data-apis/graphql/%s", instanceId, dataApiId), http.StatusOK, `{
+			"data": {
+				"id": "afdb4e9d",
+				"name": "friendly-name",
+				"status": "ready",
+				"security": {
+					"authentication_providers": [
+						{
+							"id": "1ad1b794",
+							"name": "default",
+							"type": "api-key",
+							"enabled": true
+						}
+					],
+					"cors_policy": {
+						"allowed_origins": ["https://test1.com", "https://test2.com"]
+					}
+				}
+			}
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("data-api graphql get --output table --columns id,security --instance-id %s %s", instanceId, dataApiId))
+
+	helper.AssertOut(`
+┌──────────┬────────────────────────────────────────────┬──────────────────────────────────────┐
+│ ID       │ SECURITY.AUTHENTICATION_PROVIDERS          │ SECURITY.CORS_POLICY.ALLOWED_ORIGINS │
+├──────────┼────────────────────────────────────────────┼──────────────────────────────────────┤
+│ afdb4e9d │ ┌─────────┬──────────┬─────────┬─────────┐ │ https://test1.com                    │
+│          │ │ ENABLED │ ID       │ NAME    │ TYPE    │ │ https://test2.com                    │
+│          │ ├─────────┼──────────┼─────────┼─────────┤ │                                      │
+│          │ │ true    │ 1ad1b794 │ default │ api-key │ │                                      │
+│          │ └─────────┴──────────┴─────────┴─────────┘ │                                      │
+└──────────┴────────────────────────────────────────────┴──────────────────────────────────────┘
+`)
+}
+
 func TestGetGraphQLDataApiIncludingGraphQLServerErrors(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -107,3 +151,41 @@ func TestGetGraphQLDataApiIncludingGraphQLServerErrors(t *testing.T) {
 		]
 	}`)
 }
+
+func TestGetGraphQLDataApiByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	instancesMock := helper.NewRequestHandlerMock("GET /v1beta5/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production"}
+		]
+	}`)
+	dataApisMock := helper.NewRequestHandlerMock("GET /v1beta5/instances/2f49c2b3/data-apis/graphql", http.StatusOK, `{
+		"data": [
+			{"id": "afdb4e9d", "name": "friendly-name"},
+			{"id": "23ea345a", "name": "other-name"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1beta5/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusOK, `{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "friendly-name"
+		}
+	}`)
+
+	helper.ExecuteCommand("data-api graphql get --output json --instance-id name:Production friendly-name")
+
+	instancesMock.AssertCalledTimes(1)
+	dataApisMock.AssertCalledTimes(1)
+	getMock.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "afdb4e9d",
+			"name": "friendly-name"
+		}
+	}`)
+}
@@ -23,6 +23,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewDeleteCmd(cfg))
 	cmd.AddCommand(NewResumeCmd(cfg))
 	cmd.AddCommand(NewPauseCmd(cfg))
+	cmd.AddCommand(NewWaitCmd(cfg))
 
 	return cmd
 }
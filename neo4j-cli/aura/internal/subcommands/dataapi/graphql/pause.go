@@ -6,6 +6,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -25,9 +27,13 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/pause", instanceId, args[0])
+			instanceId, dataApiId, err := api.ResolveGraphQLDataApiId(cmd.Context(), cfg, instanceId, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/data-apis/graphql/%s/pause", instanceId, dataApiId)
 
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
 			if err != nil {
@@ -36,11 +42,14 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 
 			// NOTE: pause should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "url"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for GraphQL Data API to be paused...")
-					pollResponse, err := api.PollGraphQLDataApi(cfg, instanceId, args[0], api.GraphQLDataApiStatusPausing)
+					pollResponse, err := api.PollGraphQLDataApi(cmd.Context(), cfg, instanceId, dataApiId, api.UntilStatus(api.GraphQLDataApiStatusPaused))
 					if err != nil {
 						return err
 					}
@@ -53,9 +62,11 @@ Pausing a GraphQL Data API is an asynchronous operation. Use the --await flag to
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance to pause the Data API for")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until GraphQL Data API is paused.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
@@ -23,18 +23,18 @@ func TestUpdateGraphQLDataApiFlagsValidation(t *testing.T) {
 	}{
 		"provide only one type defs flag": {
 			executedCommand: fmt.Sprintf("data-api graphql update --output json --instance-id %s --type-definitions bla --type-definitions-file blabla %s", instanceId, dataApiId),
-			expectedError:   "Error: if any flags in the group [type-definitions type-definitions-file] are set none of the others can be; [type-definitions type-definitions-file] were all set",
+			expectedError:   "if any flags in the group [type-definitions type-definitions-file] are set none of the others can be; [type-definitions type-definitions-file] were all set",
 		},
 		"invalid type defs": {
 			executedCommand: fmt.Sprintf("data-api graphql update --output json --instance-id %s --type-definitions bla %s", instanceId, dataApiId),
-			expectedError:   "Error: provided type definitions are not valid base64",
+			expectedError:   "provided type definitions are not valid base64",
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			helper.ExecuteCommand(tt.executedCommand)
-			helper.AssertErr(tt.expectedError)
+			helper.AssertErrMessage(tt.expectedError)
 		})
 	}
 }
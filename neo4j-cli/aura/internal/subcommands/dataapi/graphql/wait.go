@@ -0,0 +1,46 @@
+package graphql
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewWaitCmd(cfg *clicfg.Config) *cobra.Command {
+	var instanceId string
+
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Waits for a GraphQL Data API to reach a status",
+		Long: `Waits until a GraphQL Data API reaches the given status, ready by default, or until it is deleted when using --for-deletion.
+
+The command fails early if the GraphQL Data API ends in a failure status.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			flags.BindPollingFlags(cmd, cfg)
+
+			instanceId, dataApiId, err := api.ResolveGraphQLDataApiId(cmd.Context(), cfg, instanceId, args[0])
+			if err != nil {
+				return err
+			}
+
+			pollResponse, err := api.PollGraphQLDataApi(cmd.Context(), cfg, instanceId, dataApiId, flags.WaitUntil(cmd))
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("GraphQL Data API Status:", pollResponse.Data.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance the GraphQL Data API belongs to")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
+	cmd.MarkFlagRequired("instance-id")
+
+	flags.AddWaitFlags(cmd, cfg, api.GraphQLDataApiStatusReady, api.GraphQLDataApiStatuses)
+	return cmd
+}
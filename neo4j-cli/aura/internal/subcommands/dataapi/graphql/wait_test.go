@@ -0,0 +1,33 @@
+package graphql_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestWaitGraphQLDataApi(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.beta-enabled", true)
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1beta5/instances/2f49c2b3/data-apis/graphql/afdb4e9d", http.StatusOK, `{
+			"data": {
+				"id": "afdb4e9d",
+				"status": "pausing"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "afdb4e9d",
+				"status": "paused"
+			}
+		}`)
+
+	helper.ExecuteCommand("data-api graphql wait afdb4e9d --instance-id 2f49c2b3 --for-status paused")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOut("GraphQL Data API Status: paused")
+}
@@ -11,6 +11,7 @@ import (
 
 import (
 	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 )
 
 func NewCmd(cfg *clicfg.Config) *cobra.Command {
@@ -38,7 +39,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
 
-			return nil
+			return output.ValidateTemplate(cmd, cfg)
 		},
 	}
 
@@ -5,6 +5,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -81,7 +83,7 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, "/graph-analytics/sessions", &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, "/graph-analytics/sessions", &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
 			})
@@ -91,19 +93,25 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 
 			// NOTE: Return 202 if new session gets created and 200 if existing session was found
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "memory", "status", "created_at"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "memory", "status", "created_at"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for session to be ready...")
 
-					respData := api.ParseBody(resBody)
+					respData, err := api.ParseBody(resBody)
+					if err != nil {
+						return err
+					}
 					status := respData.AsArray()[0]["status"]
 					sessionID := respData.AsArray()[0]["id"].(string)
 					if status == "Ready" {
 						return nil
 					}
 
-					pollResponse, err := api.PollGraphAnalyticsSessionReady(cfg, sessionID, api.GraphAnalyticsSessionWaitingStatus)
+					pollResponse, err := api.PollGraphAnalyticsSessionReady(cmd.Context(), cfg, sessionID)
 					if err != nil {
 						return err
 					}
@@ -123,14 +131,18 @@ func NewCreateCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.MarkFlagRequired(nameFlag)
 
 	cmd.Flags().StringVar(&tenant_id, tenantIdFlag, "", "The Aura project/tenant ID")
+	cmd.RegisterFlagCompletionFunc(tenantIdFlag, completion.Tenants(cfg))
 
 	cmd.Flags().StringVar(&cloudProvider, cloudProviderFlag, "", "The cloud provider hosting the session.")
+	cmd.RegisterFlagCompletionFunc(cloudProviderFlag, cobra.FixedCompletions(flags.CloudProviderValues, cobra.ShellCompDirectiveNoFileComp))
 	cmd.Flags().StringVar(&region, regionFlag, "", "The region where the session is hosted.")
 
 	cmd.Flags().StringVar(&instance_id, instanceIdFlag, "", "The ID of the instance to create the session for.")
+	cmd.RegisterFlagCompletionFunc(instanceIdFlag, completion.Instances(cfg))
 	cmd.Flags().StringVar(&ttl, ttlFlag, "", "This optional parameter specifies the time-to-live of the session. The session will be marked as expired if the session was unused for the provided duration.")
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created session is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
+
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -167,3 +169,35 @@ Waiting for session to be ready...
 Session Status: Ready
 	`)
 }
+
+func TestCreateSessionWithAwaitExpired(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/graph-analytics/sessions", http.StatusAccepted, `{
+  "data": {
+    "id": "559c94c7-15de43fg",
+    "name": "people-and-fruits-with-db",
+    "status": ""
+  }
+}`)
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions/559c94c7-15de43fg", http.StatusOK, `{
+			"data": {
+				"id": "559c94c7-15de43fg",
+				"status": "Creating"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "559c94c7-15de43fg",
+				"status": "Expired"
+			}
+		}`)
+
+	helper.ExecuteCommand("graph-analytics session create --name session1 --memory 4GB --instance-id 559c94c7 --await")
+
+	getMock.AssertCalledTimes(2)
+
+	helper.AssertErrMessage(`session 559c94c7-15de43fg ended in failure status "Expired" while waiting for "Ready"`)
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
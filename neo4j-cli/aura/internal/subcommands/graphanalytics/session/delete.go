@@ -17,10 +17,14 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Delete a Graph Analytics Serverless session",
 		Long:  `This subcommand deletes a Graph Analytics Serverless session by id.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/graph-analytics/sessions/%s", args[0])
-
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			sessionId, err := api.ResolveGraphAnalyticsSessionId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/graph-analytics/sessions/%s", sessionId)
+
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodDelete,
 			})
 			if err != nil {
@@ -28,7 +32,9 @@ func NewDeleteCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusAccepted {
-				output.PrintBody(cmd, cfg, resBody, []string{"id"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id"}); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
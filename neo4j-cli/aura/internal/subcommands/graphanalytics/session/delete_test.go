@@ -58,6 +58,6 @@ func TestDeleteSessionError(t *testing.T) {
 	mockHandler.AssertCalledWithMethod(http.MethodDelete)
 
 	helper.AssertOut("")
-	helper.AssertErr("Error: [session with id s-f5138f3b-7956 not found]")
+	helper.AssertErrMessage("[session with id s-f5138f3b-7956 not found]")
 
 }
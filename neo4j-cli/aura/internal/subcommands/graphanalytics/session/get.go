@@ -17,10 +17,14 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 		Short: "Get a Graph Analytics Serverless session",
 		Long:  `This subcommand returns the details of a Graph Analytics Serverless session.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/graph-analytics/sessions/%s", args[0])
-
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			sessionId, err := api.ResolveGraphAnalyticsSessionId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/graph-analytics/sessions/%s", sessionId)
+
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
 			if err != nil {
@@ -28,7 +32,7 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{
+				if err := output.PrintBody(cmd, cfg, resBody, output.DefaultColumns(cfg, "session", []string{
 					"id",
 					"name",
 					"memory",
@@ -41,7 +45,9 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 					"host",
 					"expiry_date",
 					"instance_id",
-				})
+				})); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
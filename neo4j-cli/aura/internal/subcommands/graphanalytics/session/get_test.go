@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -82,6 +83,47 @@ func TestGetSessionError(t *testing.T) {
 	mockHandler.AssertCalledWithMethod(http.MethodGet)
 
 	helper.AssertOut("")
-	helper.AssertErr("Error: [session with id s-f5138f3b-7956 not found]")
+	helper.AssertErrMessage("[session with id s-f5138f3b-7956 not found]")
 
 }
+
+func TestGetSessionByName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{
+		"data": [
+			{"id": "559c94c7-15de43fg", "name": "people-and-fruits-with-db"},
+			{"id": "42-24", "name": "other-session"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions/559c94c7-15de43fg", http.StatusOK, `{
+		"data": {
+			"id": "559c94c7-15de43fg",
+			"name": "people-and-fruits-with-db"
+		}
+	}`)
+
+	helper.ExecuteCommand("graph-analytics session get name:people-and-fruits-with-db")
+
+	getMock.AssertCalledTimes(1)
+
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "559c94c7-15de43fg",
+			"name": "people-and-fruits-with-db"
+		}
+	}`)
+}
+
+func TestGetSessionByUnknownName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions", http.StatusOK, `{"data": [{"id": "42-24", "name": "other-session"}]}`)
+
+	helper.ExecuteCommand("graph-analytics session get name:people-and-fruits-with-db")
+
+	helper.AssertErrMessage(`no session named "people-and-fruits-with-db"`)
+	helper.AssertExitCode(clierr.ExitCodeNotFound)
+}
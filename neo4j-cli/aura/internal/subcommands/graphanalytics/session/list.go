@@ -5,6 +5,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -39,7 +41,7 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
 			})
@@ -48,15 +50,22 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "status", "tenant_id", "cloud_provider", "ttl"})
+				if err := output.PrintList(cmd, cfg, resBody, output.DefaultColumns(cfg, "session", []string{"id", "name", "status", "tenant_id", "cloud_provider", "ttl"})); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&tenantId, "tenant-id", "", "An optional Project ID to filter sessions in a project/tenant")
+	cmd.RegisterFlagCompletionFunc("tenant-id", completion.Tenants(cfg))
 	cmd.Flags().StringVar(&organizationId, "organization-id", "", "An optional Organization ID to filter sessions in an organization")
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "An optional Instance ID to filter for sessions attached to an instance")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
+
+	flags.AddFilterFlags(cmd)
+	flags.AddNameFilterFlag(cmd)
 
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -86,6 +87,54 @@ func TestListSessions(t *testing.T) {
 }`)
 }
 
+func TestListSessionsWithTemplate(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/graph-analytics/sessions", http.StatusOK, `{ "data": [
+						{
+						  "id": "s-04de43fe-67ab-4",
+						  "status": "Ready",
+						  "created_at": "2025-04-04T09:32:35Z",
+						  "expiry_date": "2025-04-11T09:32:35Z",
+						  "regions": ["francecentral", "westeurope"]
+						},
+						{
+						  "id": "559c94c7-15de43fg",
+						  "status": "Creating",
+						  "created_at": "2025-04-05T10:00:00Z",
+						  "expiry_date": null,
+						  "regions": ["europe-west1"]
+						}
+				]
+			}`)
+
+	helper.ExecuteCommand(`graph-analytics session list --output template --template '{{range .}}{{.id | pad 18}} {{.created_at | formatTime "2006-01-02"}} {{.expiry_date | formatTime "Jan 2"}} {{.regions | join ","}}{{"\n"}}{{end}}'`)
+
+	helper.AssertOut(`
+s-04de43fe-67ab-4  2025-04-04 Apr 11 francecentral,westeurope
+559c94c7-15de43fg  2025-04-05  europe-west1`)
+}
+
+func TestListSessionsWithTemplateFailingToRender(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/graph-analytics/sessions", http.StatusOK, `{ "data": [
+						{
+						  "id": "s-04de43fe-67ab-4",
+						  "created_at": "4 April 2025"
+						}
+				]
+			}`)
+
+	helper.ExecuteCommand(`graph-analytics session list --output template --template '{{range .}}{{.id}} {{.created_at | formatTime "2006-01-02"}}{{end}}'`)
+
+	helper.AssertOut("")
+	helper.AssertErr(`Error: cannot render template: template: output:1:35: executing "output" at <formatTime "2006-01-02">: error calling formatTime: parsing time "4 April 2025" as "2006-01-02T15:04:05Z07:00": cannot parse "4 April 2025" as "2006"`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
 func TestListSessionsWithFilters(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -113,5 +162,5 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.ExecuteCommand("graph-analytics session list --output invalid")
 
-	helper.AssertErr("Error: invalid output value specified: invalid")
+	helper.AssertErrMessage("invalid output value specified: invalid")
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 	"strings"
 )
@@ -33,7 +34,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
 
-			return nil
+			return output.ValidateTemplate(cmd, cfg)
 		},
 	}
 
@@ -41,6 +42,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewListCmd(cfg))
 	cmd.AddCommand(NewDeleteCmd(cfg))
 	cmd.AddCommand(NewGetCmd(cfg))
+	cmd.AddCommand(NewWaitCmd(cfg))
 
 	cmd.PersistentFlags().String("auth-url", "", "")
 	cmd.PersistentFlags().String("base-url", "", "")
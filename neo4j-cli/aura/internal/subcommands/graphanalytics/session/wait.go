@@ -0,0 +1,39 @@
+package session
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewWaitCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Waits for a Graph Analytics session to reach a status",
+		Long: `Waits until a Graph Analytics session reaches the given status, Ready by default, or until it is deleted when using --for-deletion.
+
+The command fails early if the session ends in a failure status.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			flags.BindPollingFlags(cmd, cfg)
+
+			sessionId, err := api.ResolveGraphAnalyticsSessionId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			pollResponse, err := api.PollGraphAnalyticsSession(cmd.Context(), cfg, sessionId, flags.WaitUntil(cmd))
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Session Status:", pollResponse.Data.Status)
+			return nil
+		},
+	}
+
+	flags.AddWaitFlags(cmd, cfg, api.GraphAnalyticsSessionReady, api.GraphAnalyticsSessionStatuses)
+	return cmd
+}
@@ -0,0 +1,31 @@
+package session_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestWaitSession(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/graph-analytics/sessions/559c94c7-15de43fg", http.StatusOK, `{
+			"data": {
+				"id": "559c94c7-15de43fg",
+				"status": "Creating"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "559c94c7-15de43fg",
+				"status": "Ready"
+			}
+		}`)
+
+	helper.ExecuteCommand("graph-analytics session wait 559c94c7-15de43fg")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOut("Session Status: Ready")
+}
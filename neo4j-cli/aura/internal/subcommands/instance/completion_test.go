@@ -0,0 +1,178 @@
+package instance_test
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/common/clicfg/credentials"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestCompleteInstanceArg(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production"},
+			{"id": "b51dc964", "name": "My Staging"}
+		]
+	}`)
+
+	helper.ExecuteCommand("__complete instance get ''")
+
+	listMock.AssertCalledTimes(1)
+	helper.AssertOut(`2f49c2b3	Production
+Production	2f49c2b3
+b51dc964	My Staging
+:4`)
+}
+
+func TestCompleteInstanceArgFromCache(t *testing.T) {
+	tests := map[string]struct {
+		expiry           string
+		expectedRequests int
+		expectedOut      string
+	}{
+		"fresh": {
+			expiry:           "2999-01-01T00:00:00Z",
+			expectedRequests: 0,
+			expectedOut: `cached01	Cached
+:4`,
+		},
+		"expired": {
+			expiry:           "2000-01-01T00:00:00Z",
+			expectedRequests: 1,
+			expectedOut: `2f49c2b3	Production
+Production	2f49c2b3
+:4`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "Production"}]}`)
+
+			account := api.CacheAccount(&credentials.AuraCredential{AccessToken: "dsa"}, helper.Server.URL+"/oauth/token")
+			key := fmt.Sprintf("%s %s /instances", account, helper.Server.URL)
+			helper.SetFile(filepath.Join(clicfg.ConfigPrefix, "neo4j", "cli", "completion-cache.json"), fmt.Sprintf(`{%q: {"candidates": ["cached01\tCached"], "expiry": %q}}`, key, tt.expiry))
+
+			helper.ExecuteCommand("__complete instance pause ''")
+
+			listMock.AssertCalledTimes(tt.expectedRequests)
+			helper.AssertOut(tt.expectedOut)
+		})
+	}
+}
+
+func TestCompleteInstanceArgSeparatesAccountsOfEnvironmentCredentials(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.KeepFs()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "Production"}]}`).
+		AddResponse(http.StatusOK, `{"data": [{"id": "b51dc964", "name": "Staging"}]}`)
+
+	t.Setenv("AURA_CLIENT_SECRET", "env-client-secret")
+
+	t.Setenv("AURA_CLIENT_ID", "first-client-id")
+	helper.ExecuteCommand("__complete instance get ''")
+	t.Setenv("AURA_CLIENT_ID", "second-client-id")
+	helper.ExecuteCommand("__complete instance get ''")
+
+	listMock.AssertCalledTimes(2)
+	helper.AssertOut(`2f49c2b3	Production
+Production	2f49c2b3
+:4
+b51dc964	Staging
+Staging	b51dc964
+:4`)
+}
+
+func TestCompleteInstanceArgOnlyOnce(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("__complete instance get 2f49c2b3 ''")
+
+	listMock.AssertCalledTimes(0)
+	helper.AssertOut(":4")
+}
+
+func TestCompleteCreateFlags(t *testing.T) {
+	tests := map[string]struct {
+		command     string
+		expectedOut string
+	}{
+		"memory": {
+			command: "__complete instance create --memory ''",
+			expectedOut: `1GB
+2GB
+4GB
+8GB
+16GB
+24GB
+32GB
+48GB
+64GB
+128GB
+192GB
+256GB
+384GB
+512GB
+:4`,
+		},
+		"cloud provider": {
+			command: "__complete instance create --cloud-provider ''",
+			expectedOut: `aws
+azure
+gcp
+:4`,
+		},
+		"tenant": {
+			command: "__complete instance create --tenant-id ''",
+			expectedOut: `YOUR_TENANT_ID	Production tenant
+:4`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("GET /v1/tenants", http.StatusOK, `{"data": [{"id": "YOUR_TENANT_ID", "name": "Production tenant"}]}`)
+
+			helper.ExecuteCommand(tt.command)
+
+			helper.AssertOut(tt.expectedOut)
+		})
+	}
+}
+
+func TestCompleteOverwriteSourceSnapshot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	snapshotsMock := helper.NewRequestHandlerMock("GET /v1/instances/191b0da2/snapshots", http.StatusOK, `{
+		"data": [
+			{"snapshot_id": "afdb4e9d-6ba6-4d45-b951-f82843dcbca6", "status": "Completed", "timestamp": "2024-09-12T13:51:45Z"}
+		]
+	}`)
+
+	helper.ExecuteCommand("__complete instance overwrite 2f49c2b3 --source-instance-id 191b0da2 --source-snapshot-id ''")
+
+	snapshotsMock.AssertCalledTimes(1)
+	helper.AssertOut(`afdb4e9d-6ba6-4d45-b951-f82843dcbca6	2024-09-12T13:51:45Z Completed
+:4`)
+}
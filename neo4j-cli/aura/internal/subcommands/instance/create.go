@@ -8,6 +8,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
@@ -122,7 +123,7 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 			}
 
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, "/instances", &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, "/instances", &api.RequestConfig{
 				PostBody: body,
 				Method:   http.MethodPost,
 			})
@@ -132,16 +133,19 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 
 			// NOTE: Instance create should not return OK (200), it always returns 202, checking both just in case
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "connection_url", "username", "password", "cloud_provider", "region", "type"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "connection_url", "username", "password", "cloud_provider", "region", "type"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for instance to be ready...")
 					var response api.CreateInstanceResponse
 					if err := json.Unmarshal(resBody, &response); err != nil {
 						return err
 					}
 
-					pollResponse, err := api.PollInstance(cfg, response.Data.Id, api.InstanceStatusCreating)
+					pollResponse, err := api.PollInstance(cmd.Context(), cfg, response.Data.Id, api.UntilStatus(api.InstanceSuccessStatus...))
 					if err != nil {
 						return err
 					}
@@ -159,16 +163,20 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 	cmd.Flags().StringVar(&region, regionFlag, "", "The region where the instance is hosted.")
 
 	cmd.Flags().Var(&memory, memoryFlag, "The size of the instance memory in GB.")
+	cmd.RegisterFlagCompletionFunc(memoryFlag, cobra.FixedCompletions(flags.MemoryValues, cobra.ShellCompDirectiveNoFileComp))
 
 	cmd.Flags().StringVar(&name, nameFlag, "", "(required) The name of the instance (any UTF-8 characters with no trailing or leading whitespace).")
 	cmd.MarkFlagRequired(nameFlag)
 
 	cmd.Flags().Var(&_type, typeFlag, "(required) The type of the instance.")
+	cmd.RegisterFlagCompletionFunc(typeFlag, cobra.FixedCompletions(flags.InstanceTypeValues, cobra.ShellCompDirectiveNoFileComp))
 	cmd.MarkFlagRequired(typeFlag)
 
 	cmd.Flags().StringVar(&tenantId, tenantIdFlag, "", "The Aura tenant/project ID")
+	cmd.RegisterFlagCompletionFunc(tenantIdFlag, completion.Tenants(cfg))
 
 	cmd.Flags().Var(&cloudProvider, cloudProviderFlag, "The cloud provider hosting the instance.")
+	cmd.RegisterFlagCompletionFunc(cloudProviderFlag, cobra.FixedCompletions(flags.CloudProviderValues, cobra.ShellCompDirectiveNoFileComp))
 
 	cmd.Flags().StringVar(&customerManagedKeyId, customerManagedKeyIdFlag, "", "An optional customer managed key to be used for instance creation.")
 
@@ -177,6 +185,7 @@ For Enterprise instances you can specify a --customer-managed-key-id flag to use
 	cmd.Flags().BoolVar(&graphAnalyticsPlugin, graphAnalyticsPluginFlag, false, "An optional graph analytics plugin configuration to be set during instance creation")
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until created instance is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
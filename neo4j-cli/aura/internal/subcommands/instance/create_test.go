@@ -1,10 +1,13 @@
 package instance_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -140,8 +143,14 @@ func TestCreateProfessionalInstanceNoMemory(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: required flag(s) "memory" not set
-`)
+	helper.AssertErrJson(`{
+		"error": {
+			"code": "usage",
+			"exit_code": 2,
+			"message": "required flag(s) \"memory\" not set"
+		}
+	}`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
 }
 
 func TestCreateProfessionalInstanceNoTenant(t *testing.T) {
@@ -154,7 +163,7 @@ func TestCreateProfessionalInstanceNoTenant(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: required flag(s) "tenant-id" not set
+	helper.AssertErrMessage(`required flag(s) "tenant-id" not set
 `)
 }
 
@@ -168,7 +177,7 @@ func TestCreateProfessionalInstanceInvalidCloudProvider(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "invalid" for "--cloud-provider" flag: must be one of "aws", "azure", or "gcp"
+	helper.AssertErrMessage(`invalid argument "invalid" for "--cloud-provider" flag: must be one of "aws", "azure", or "gcp"
 `)
 }
 
@@ -182,7 +191,7 @@ func TestCreateProfessionalInstanceInvalidMemory(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "3GB" for "--memory" flag: must be one of "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", or "512GB"
+	helper.AssertErrMessage(`invalid argument "3GB" for "--memory" flag: must be one of "1GB", "2GB", "4GB", "8GB", "16GB", "24GB", "32GB", "48GB", "64GB", "128GB", "192GB", "256GB", "384GB", or "512GB"
 `)
 }
 
@@ -196,7 +205,7 @@ func TestCreateProfessionalInstanceInvalidInstanceType(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "invalid-db" for "--type" flag: must be one of "free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", or "enterprise-ds"
+	helper.AssertErrMessage(`invalid argument "invalid-db" for "--type" flag: must be one of "free-db", "professional-db", "business-critical", "enterprise-db", "professional-ds", or "enterprise-ds"
 `)
 }
 
@@ -210,7 +219,7 @@ func TestCreateProfessionalInstanceInvalidVersion(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "6" for "--version" flag: must be one of "4" or "5"
+	helper.AssertErrMessage(`invalid argument "6" for "--version" flag: must be one of "4" or "5"
 `)
 }
 
@@ -224,7 +233,7 @@ func TestCreateFreeInstanceWithMemory(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "1GB" for "--memory" flag: must not be set when "--type" flag is set to "free-db"
+	helper.AssertErrMessage(`invalid argument "1GB" for "--memory" flag: must not be set when "--type" flag is set to "free-db"
 `)
 }
 
@@ -238,7 +247,7 @@ func TestCreateFreeInstanceWithRegion(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "europe-west1" for "--region" flag: must not be set when "--type" flag is set to "free-db"
+	helper.AssertErrMessage(`invalid argument "europe-west1" for "--region" flag: must not be set when "--type" flag is set to "free-db"
 `)
 }
 
@@ -252,7 +261,7 @@ func TestCreateFreeInstanceWithCloudProvider(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: invalid argument "gcp" for "--cloud-provider" flag: must not be set when "--type" flag is set to "free-db"
+	helper.AssertErrMessage(`invalid argument "gcp" for "--cloud-provider" flag: must not be set when "--type" flag is set to "free-db"
 `)
 }
 
@@ -266,7 +275,7 @@ func TestCreateFreeInstanceWithGraphAnalyticsPlugin(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: "--graph-analytics-plugin" flag can only be set when "--type" flag is set to "professional-db"
+	helper.AssertErrMessage(`"--graph-analytics-plugin" flag can only be set when "--type" flag is set to "professional-db"
 `)
 }
 
@@ -278,7 +287,7 @@ func TestCreateInstanceError(t *testing.T) {
 	}{
 		{
 			statusCode:    http.StatusBadRequest,
-			expectedError: "Error: [You must provide billing details in the Aura Console before creating an instance]",
+			expectedError: "[You must provide billing details in the Aura Console before creating an instance]",
 			returnBody: `{
 				"errors": [
 					{
@@ -290,7 +299,7 @@ func TestCreateInstanceError(t *testing.T) {
 		},
 		{
 			statusCode:    http.StatusMethodNotAllowed,
-			expectedError: "Error: [string]",
+			expectedError: "[string]",
 			returnBody: `{
 				"errors": [
 					{
@@ -316,7 +325,7 @@ func TestCreateInstanceError(t *testing.T) {
 			mockHandler.AssertCalledWithMethod(http.MethodPost)
 
 			helper.AssertOut("")
-			helper.AssertErr(testCase.expectedError)
+			helper.AssertErrMessage(testCase.expectedError)
 		})
 	}
 }
@@ -427,7 +436,7 @@ func TestCreateFreeInstanceWithAwait(t *testing.T) {
 		}`).AddResponse(http.StatusOK, `{
 			"data": {
 				"id": "db1d1234",
-				"status": "ready"
+				"status": "running"
 			}
 		}`)
 
@@ -455,6 +464,345 @@ func TestCreateFreeInstanceWithAwait(t *testing.T) {
 	}
 }
 Waiting for instance to be ready...
-Instance Status: ready
+Instance Status: running
 	`)
 }
+
+func TestCreateFreeInstanceWithAwaitLoadingFailed(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"name": "Instance01"
+			}
+		}`)
+
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "loading failed"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await")
+
+	getMock.AssertCalledTimes(2)
+
+	helper.AssertErrMessage(`instance db1d1234 ended in failure status "loading failed" while waiting for "running"`)
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
+
+func TestCreateFreeInstanceWithAwaitReportsProgress(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"name": "Instance01"
+			}
+		}`)
+
+	helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "running"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await --output table")
+
+	helper.AssertErr(`Status: creating (0s elapsed)
+Status: creating -> running (0s elapsed)`)
+	helper.AssertExitCode(clierr.ExitCodeOk)
+}
+
+func TestCreateFreeInstanceWithAwaitTimeout(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"name": "Instance01"
+			}
+		}`)
+
+	creatingBody := `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, creatingBody)
+	for i := 0; i < 4; i++ {
+		getMock.AddResponse(http.StatusOK, creatingBody)
+	}
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await")
+
+	getMock.AssertCalledTimes(5)
+
+	helper.AssertErrMessage("hit max retries [5] polling")
+	helper.AssertExitCode(clierr.ExitCodeTimeout)
+}
+
+func TestCreateFreeInstanceWithPollingMaxRetriesFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"name": "Instance01"
+			}
+		}`)
+
+	creatingBody := `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, creatingBody).
+		AddResponse(http.StatusOK, creatingBody).
+		AddResponse(http.StatusOK, creatingBody)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await --polling-max-retries 2 --polling-backoff")
+
+	getMock.AssertCalledTimes(2)
+
+	helper.AssertErrMessage("hit max retries [2] polling")
+	helper.AssertExitCode(clierr.ExitCodeTimeout)
+}
+
+func TestCreateFreeInstanceWithInvalidPollingFlags(t *testing.T) {
+	tests := map[string]struct {
+		flags           string
+		expectedMessage string
+	}{
+		"negative interval": {
+			flags:           "--polling-interval -1",
+			expectedMessage: `invalid argument "-1" for "--polling-interval" flag: invalid value specified for polling.interval: -1, must be a non-negative integer`,
+		},
+		"negative max retries": {
+			flags:           "--polling-max-retries -5",
+			expectedMessage: `invalid argument "-5" for "--polling-max-retries" flag: invalid value specified for polling.max-retries: -5, must be a non-negative integer`,
+		},
+		"negative await timeout": {
+			flags:           "--await-timeout -1m",
+			expectedMessage: `invalid argument "-1m" for "--await-timeout" flag: invalid value specified for await-timeout: -1m, must be a non-negative duration such as 30s or 2m`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, "")
+
+			helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await " + tt.flags)
+
+			mockHandler.AssertCalledTimes(0)
+
+			helper.AssertErrMessage(tt.expectedMessage)
+			helper.AssertExitCode(clierr.ExitCodeUsage)
+		})
+	}
+}
+
+func TestCreateFreeInstanceWithAwaitTimeoutFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"name": "Instance01"
+			}
+		}`)
+
+	creatingBody := `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, creatingBody).
+		AddResponse(http.StatusOK, creatingBody).WithDelay(time.Minute)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await --await-timeout 100ms")
+
+	getMock.AssertCalledTimes(2)
+
+	helper.AssertErrMessage("timed out after 100ms waiting for instance db1d1234, the operation is still pending in Aura with status creating")
+	helper.AssertExitCode(clierr.ExitCodeTimeout)
+}
+
+func TestCreateFreeInstanceWithAwaitInterrupted(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("POST /v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"name": "Instance01"
+			}
+		}`)
+
+	creatingBody := `{
+			"data": {
+				"id": "db1d1234",
+				"status": "creating"
+			}
+		}`
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/db1d1234", http.StatusOK, creatingBody).
+		AddResponse(http.StatusOK, creatingBody).WithDelay(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	helper.ExecuteCommandWithContext(ctx, "instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --await")
+
+	getMock.AssertCalledTimes(2)
+
+	helper.AssertErrMessage("interrupted while waiting for instance db1d1234, the operation is still pending in Aura with status creating")
+	helper.AssertExitCode(clierr.ExitCodeInterrupted)
+}
+
+func TestCreateInstanceIsNotRetriedOnUnavailableServer(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusServiceUnavailable, `{
+		"errors": [
+			{
+			"message": "Service unavailable",
+			"reason": "service-unavailable"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID")
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErrMessage("[Service unavailable]")
+}
+
+func TestCreateInstanceIsRetriedOnUnavailableServerWithRetryNonIdempotentFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusServiceUnavailable, "").WithHeaders(map[string]string{"Retry-After": "0"}).AddResponse(http.StatusAccepted, `{
+		"data": {
+			"id": "db1d1234",
+			"name": "Instance01"
+		}
+	}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --retry-non-idempotent")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "db1d1234",
+			"name": "Instance01"
+		}
+	}`)
+}
+
+func TestCreateInstanceIsRetriedWhenRateLimited(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusTooManyRequests, "").WithHeaders(map[string]string{"Retry-After": "0"}).AddResponse(http.StatusAccepted, `{
+		"data": {
+			"id": "db1d1234",
+			"name": "Instance01"
+		}
+	}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID")
+
+	mockHandler.AssertCalledTimes(2)
+	mockHandler.AssertCalledWithBody(`{"cloud_provider":"gcp","memory":"1GB","name":"Instance01","region":"europe-west1","tenant_id":"YOUR_TENANT_ID","type":"free-db","version":"5"}`)
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "db1d1234",
+			"name": "Instance01"
+		}
+	}`)
+}
+
+func TestCreateInstanceIgnoresConfiguredColumns(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.columns.instance", "id,status")
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusAccepted, `{
+			"data": {
+				"id": "db1d1234",
+				"connection_url": "YOUR_CONNECTION_URL",
+				"username": "neo4j",
+				"password": "letMeIn123!",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp",
+				"region": "europe-west1",
+				"type": "free-db",
+				"name": "Instance01"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance create --name Instance01 --type free-db --tenant-id YOUR_TENANT_ID --output csv")
+
+	helper.AssertErr("")
+	helper.AssertOut(`id,name,tenant_id,connection_url,username,password,cloud_provider,region,type
+db1d1234,Instance01,YOUR_TENANT_ID,YOUR_CONNECTION_URL,neo4j,letMeIn123!,gcp,europe-west1,free-db`)
+}
+
+func TestCreateInstanceFlagErrorFollowsOutputFlag(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.output", "table")
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, "")
+
+	helper.ExecuteCommand("instance create --output json --region europe-west1 --name Instance01 --type professional-db --memory 3GB --cloud-provider gcp --tenant-id YOUR_TENANT_ID")
+
+	mockHandler.AssertCalledTimes(0)
+
+	helper.AssertOut("")
+	helper.AssertErrJson(`{
+		"error": {
+			"code": "usage",
+			"exit_code": 2,
+			"message": "invalid argument \"3GB\" for \"--memory\" flag: must be one of \"1GB\", \"2GB\", \"4GB\", \"8GB\", \"16GB\", \"24GB\", \"32GB\", \"48GB\", \"64GB\", \"128GB\", \"192GB\", \"256GB\", \"384GB\", or \"512GB\""
+		}
+	}`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
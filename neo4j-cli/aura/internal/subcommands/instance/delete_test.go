@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -33,6 +34,7 @@ func TestDeleteInstance(t *testing.T) {
 	mockHandler.AssertCalledTimes(1)
 	mockHandler.AssertCalledWithMethod(http.MethodDelete)
 
+	helper.AssertExitCode(clierr.ExitCodeOk)
 	helper.AssertOutJson(`{
 	  "data": {
 		"cloud_provider": "gcp",
@@ -50,13 +52,15 @@ func TestDeleteInstance(t *testing.T) {
 
 func TestDeleteInstanceError(t *testing.T) {
 	testCases := []struct {
-		statusCode    int
-		expectedError string
-		returnBody    string
+		statusCode       int
+		expectedError    string
+		expectedExitCode int
+		returnBody       string
 	}{
 		{
-			statusCode:    http.StatusNotFound,
-			expectedError: "Error: [DB not found: 24d18db5]",
+			statusCode:       http.StatusNotFound,
+			expectedError:    "[DB not found: 24d18db5]",
+			expectedExitCode: clierr.ExitCodeNotFound,
 			returnBody: `{
 				"errors": [
 					{
@@ -67,8 +71,9 @@ func TestDeleteInstanceError(t *testing.T) {
 			  }`,
 		},
 		{
-			statusCode:    http.StatusConflict,
-			expectedError: "Error: [The database is current undergoing an operation: resuming]",
+			statusCode:       http.StatusConflict,
+			expectedError:    "[The database is current undergoing an operation: resuming]",
+			expectedExitCode: clierr.ExitCodeConflict,
 			returnBody: `{
 				"errors": [
 				  {
@@ -95,7 +100,8 @@ func TestDeleteInstanceError(t *testing.T) {
 			mockHandler.AssertCalledWithMethod(http.MethodDelete)
 
 			helper.AssertOut("")
-			helper.AssertErr(testCase.expectedError)
+			helper.AssertErrMessage(testCase.expectedError)
+			helper.AssertExitCode(testCase.expectedExitCode)
 		})
 	}
 }
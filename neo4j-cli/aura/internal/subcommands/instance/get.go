@@ -8,21 +8,26 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 )
 
 func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 	return &cobra.Command{
-		Use:   "get <id>",
-		Short: "Returns instance details",
-		Long:  "This endpoint returns details about a specific Aura Instance.",
-		Args:  cobra.ExactArgs(1),
+		Use:               "get <id>",
+		Short:             "Returns instance details",
+		Long:              "This endpoint returns details about a specific Aura Instance.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.InstanceArg(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			instanceId := args[0]
+			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s", instanceId)
 
-			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
 			if err != nil {
@@ -34,7 +39,9 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				output.PrintBody(cmd, cfg, resBody, fields)
+				if err := output.PrintBody(cmd, cfg, resBody, output.DefaultColumns(cfg, "instance", fields)); err != nil {
+					return err
+				}
 			}
 
 			return nil
@@ -43,7 +50,10 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 }
 
 func getFields(resBody []byte) ([]string, error) {
-	responseBody := api.ParseBody(resBody)
+	responseBody, err := api.ParseBody(resBody)
+	if err != nil {
+		return nil, err
+	}
 
 	fields := []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage", "customer_managed_key_id"}
 	instance, err := responseBody.GetSingleOrError()
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
@@ -134,6 +136,46 @@ func TestGetProfessionalInstanceWithTableOutput(t *testing.T) {
 
 }
 
+func TestGetInstanceWithYamlOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"name": "Production",
+				"status": "running",
+				"tenant_id": "YOUR_TENANT_ID",
+				"cloud_provider": "gcp",
+				"memory": "8GB",
+				"graph_nodes": 15,
+				"secondaries_count": 0,
+				"cdc_enrichment_mode": "OFF",
+				"vector_optimized": false
+			}
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s --output yaml", instanceId))
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertOut(`
+data:
+  cdc_enrichment_mode: "OFF"
+  cloud_provider: gcp
+  graph_nodes: 15
+  id: 2f49c2b3
+  memory: 8GB
+  name: Production
+  secondaries_count: 0
+  status: running
+  tenant_id: YOUR_TENANT_ID
+  vector_optimized: false
+`)
+}
+
 func TestGetInstanceNotFoundError(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -154,7 +196,66 @@ func TestGetInstanceNotFoundError(t *testing.T) {
 	mockHandler.AssertCalledTimes(1)
 	mockHandler.AssertCalledWithMethod(http.MethodGet)
 
+	helper.AssertErrMessage(fmt.Sprintf("[DB not found: %s]", instanceId))
+	helper.AssertExitCode(clierr.ExitCodeNotFound)
+}
+
+func TestGetInstanceNotFoundErrorDocument(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusNotFound, fmt.Sprintf(`{
+		"errors": [
+			{
+			"message": "DB not found: %s",
+			"reason": "db-not-found",
+			"field": "dbid"
+			}
+		]
+	}`, instanceId)).WithHeaders(map[string]string{"X-Request-Id": "c5f0e3a1"})
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	helper.AssertOut("")
+	helper.AssertErrJson(fmt.Sprintf(`{
+		"error": {
+			"code": "not-found",
+			"exit_code": 4,
+			"status": 404,
+			"message": "[DB not found: %s]",
+			"errors": [
+				{
+					"message": "DB not found: %s",
+					"reason": "db-not-found",
+					"field": "dbid"
+				}
+			],
+			"request_id": "c5f0e3a1"
+		}
+	}`, instanceId, instanceId))
+}
+
+func TestGetInstanceNotFoundErrorWithTextOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusNotFound, fmt.Sprintf(`{
+		"errors": [
+			{
+			"message": "DB not found: %s",
+			"reason": "db-not-found"
+			}
+		]
+	}`, instanceId))
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s --output table", instanceId))
+
 	helper.AssertErr(fmt.Sprintf("Error: [DB not found: %s]", instanceId))
+	helper.AssertExitCode(clierr.ExitCodeNotFound)
 }
 
 func TestGetHasCmiEndpoint(t *testing.T) {
@@ -208,10 +309,378 @@ func TestUnauthorizedAccessTokenRefresh(t *testing.T) {
 	}
 ]`)
 
-			helper.AssertErr(`Error: [
+			helper.AssertErrMessage(`[
 	string,
 	Request failed authorization - access token has been cleared and will be refreshed on next request - please retry the command
 ]`)
+			helper.AssertExitCode(clierr.ExitCodeAuth)
+		})
+	}
+}
+
+func TestGetInstanceWithClientCredentialsFromEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_CLIENT_ID", "env-client-id")
+	t.Setenv("AURA_CLIENT_SECRET", "env-client-secret")
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusOK, `{"data": {"id": "2f49c2b3"}}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	mockHandler.AssertCalledWithHeader("Authorization", "Bearer <token>")
+
+	// The stored credential is not used, nor is the token obtained stored
+	helper.AssertCredentialsValue("aura.credentials.#.access-token", `["dsa"]`)
+	helper.AssertOutJson(`{"data": {"id": "2f49c2b3"}}`)
+}
+
+func TestGetInstanceWithAccessTokenFromEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_ACCESS_TOKEN", "env-token")
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusOK, `{"data": {"id": "2f49c2b3"}}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	mockHandler.AssertCalledWithHeader("Authorization", "Bearer env-token")
+	helper.AssertOutJson(`{"data": {"id": "2f49c2b3"}}`)
+}
+
+func TestGetInstanceWithRejectedAccessTokenFromEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_ACCESS_TOKEN", "env-token")
+
+	instanceId := "2f49c2b3"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusUnauthorized, `{"errors": [{"message": "string", "reason": "string"}]}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	helper.AssertErrMessage(`[
+	string,
+	Request failed authorization - the access token given by AURA_ACCESS_TOKEN was rejected, please provide a new one
+]`)
+	helper.AssertExitCode(clierr.ExitCodeAuth)
+}
+
+func TestGetInstanceWithIncompleteCredentialsFromEnvironment(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	t.Setenv("AURA_CLIENT_ID", "env-client-id")
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	helper.AssertErrMessage("both AURA_CLIENT_ID and AURA_CLIENT_SECRET must be set, or else AURA_ACCESS_TOKEN")
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
+func TestGetInstanceRetriesUnavailableServer(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusServiceUnavailable, `{
+		"errors": [
+			{
+			"message": "Service unavailable",
+			"reason": "service-unavailable"
+			}
+		]
+	}`).AddResponse(http.StatusTooManyRequests, "").WithHeaders(map[string]string{"Retry-After": "0"}).AddResponse(http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"status": "running"
+		}
+	}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	mockHandler.AssertCalledTimes(3)
+	mockHandler.AssertCalledWithMethod(http.MethodGet)
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Production",
+			"status": "running"
+		}
+	}`)
+}
+
+func TestGetInstanceStopsRetryingAfterMaxRetries(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+	errorBody := `{
+		"errors": [
+			{
+			"message": "Bad gateway",
+			"reason": "bad-gateway"
+			}
+		]
+	}`
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusBadGateway, errorBody).AddResponse(http.StatusBadGateway, errorBody).AddResponse(http.StatusBadGateway, errorBody)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s --retry-max-retries 1", instanceId))
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertErrMessage("[Bad gateway]")
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
+
+func TestGetInstanceDoesNotRetryWhenRetryAfterExceedsMaxBackoff(t *testing.T) {
+	tests := map[string]struct {
+		statusCode       int
+		body             string
+		expectedMessage  string
+		expectedExitCode int
+	}{
+		"rate limited": {
+			statusCode:       http.StatusTooManyRequests,
+			body:             "",
+			expectedMessage:  "server rate limit exceeded, suggested cool-off period is 120 seconds before rerunning the command",
+			expectedExitCode: clierr.ExitCodeRateLimited,
+		},
+		"unavailable": {
+			statusCode:       http.StatusServiceUnavailable,
+			body:             `{"errors": [{"message": "Service unavailable", "reason": "service-unavailable"}]}`,
+			expectedMessage:  "[Service unavailable], suggested cool-off period is 120 seconds before rerunning the command",
+			expectedExitCode: clierr.ExitCodeUpstream,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			instanceId := "2f49c2b3"
+
+			mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), tt.statusCode, tt.body).WithHeaders(map[string]string{"Retry-After": "120"})
+
+			helper.ExecuteCommand(fmt.Sprintf("instance get %s --retry-max-backoff 60", instanceId))
+
+			mockHandler.AssertCalledTimes(1)
+
+			helper.AssertErrMessage(tt.expectedMessage)
+			helper.AssertExitCode(tt.expectedExitCode)
+		})
+	}
+}
+
+func TestGetInstanceWaitsForRetryAfter(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusTooManyRequests, "").WithHeaders(map[string]string{"Retry-After": "1"}).AddResponse(http.StatusOK, `{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Instance01"
+		}
+	}`)
+
+	start := time.Now()
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s --retry-max-backoff 5", instanceId))
+
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertErr("")
+	helper.AssertOutJson(`{
+		"data": {
+			"id": "2f49c2b3",
+			"name": "Instance01"
+		}
+	}`)
+}
+
+func TestGetInstanceTimesOut(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusOK, "{}").WithDelay(time.Minute)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s --timeout 100ms --retry-max-retries 0", instanceId))
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErrMessage(fmt.Sprintf("GET %s/v1/instances/%s did not complete within 100ms", helper.Server.URL, instanceId))
+	helper.AssertExitCode(clierr.ExitCodeTimeout)
+}
+
+func TestGetInstanceWithUnexpectedStatusCode(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusTeapot, "teapot")
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	mockHandler.AssertCalledTimes(1)
+
+	assert.Contains(t, helper.PrintErr(), "unexpected status code 418 and body teapot running CLI with args")
+	helper.AssertExitCode(clierr.ExitCodeFatal)
+}
+
+func TestGetInstanceWithMalformedErrorBody(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusNotFound, "<html>not found</html>")
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErrMessage("unexpected response from the Aura API [status 404 Not Found]")
+	helper.AssertExitCode(clierr.ExitCodeNotFound)
+}
+
+func TestGetInstanceWithProxyErrorPage(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), 520, "<html>web server returned an unknown error</html>")
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s --retry-max-retries 0", instanceId))
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErrMessage("unexpected response from the Aura API [status 520]")
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
+
+func TestGetInstanceByName(t *testing.T) {
+	tests := map[string]struct {
+		reference    string
+		expectedPath string
+	}{
+		"bare name":        {reference: "Production", expectedPath: "/v1/instances/2f49c2b3"},
+		"prefixed name":    {reference: "name:Production", expectedPath: "/v1/instances/2f49c2b3"},
+		"id shaped name":   {reference: "name:deadbeef", expectedPath: "/v1/instances/432392ae"},
+		"name with spaces": {reference: "'name:My Production'", expectedPath: "/v1/instances/6b5bc6b9"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+				"data": [
+					{"id": "2f49c2b3", "name": "Production"},
+					{"id": "b51dc964", "name": "Staging"},
+					{"id": "deadbeef", "name": "Other"},
+					{"id": "432392ae", "name": "deadbeef"},
+					{"id": "6b5bc6b9", "name": "My Production"}
+				]
+			}`)
+			getMock := helper.NewRequestHandlerMock("GET /v1/instances/{id}", http.StatusOK, `{"data": {"id": "2f49c2b3"}}`)
+
+			helper.ExecuteCommand(fmt.Sprintf("instance get %s", tt.reference))
+
+			listMock.AssertCalledTimes(1)
+			getMock.AssertCalledTimes(1)
+			assert.Equal(t, tt.expectedPath, getMock.Calls[0].Path)
+		})
+	}
+}
+
+func TestGetInstanceById(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	listMock := helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": []}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3"}}`)
+
+	helper.ExecuteCommand("instance get 2f49c2b3")
+
+	listMock.AssertCalledTimes(0)
+	getMock.AssertCalledTimes(1)
+}
+
+func TestGetInstanceByAmbiguousName(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{
+		"data": [
+			{"id": "2f49c2b3", "name": "Production"},
+			{"id": "432392ae", "name": "Production"}
+		]
+	}`)
+	getMock := helper.NewRequestHandlerMock("GET /v1/instances/{id}", http.StatusOK, `{"data": {}}`)
+
+	helper.ExecuteCommand("instance get Production")
+
+	getMock.AssertCalledTimes(0)
+	helper.AssertErrMessage(`2 instances are named "Production", use one of their IDs instead: 2f49c2b3, 432392ae`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
+func TestGetInstanceByUnknownName(t *testing.T) {
+	tests := map[string]struct {
+		reference       string
+		expectedMessage string
+	}{
+		"bare name":     {reference: "Production", expectedMessage: `no instance with the ID or name "Production"`},
+		"prefixed name": {reference: "name:2f49c2b3", expectedMessage: `no instance named "2f49c2b3"`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("GET /v1/instances", http.StatusOK, `{"data": [{"id": "2f49c2b3", "name": "Staging"}]}`)
+
+			helper.ExecuteCommand(fmt.Sprintf("instance get %s", tt.reference))
+
+			helper.AssertErrMessage(tt.expectedMessage)
+			helper.AssertExitCode(clierr.ExitCodeNotFound)
 		})
 	}
 }
+
+func TestGetInstanceWithMalformedResponse(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	instanceId := "2f49c2b3"
+
+	mockHandler := helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s", instanceId), http.StatusOK, `{"data": {"id": `)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance get %s", instanceId))
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertErrMessage("cannot parse response body: unexpected end of JSON input")
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/subcommands/instance/snapshot"
 
 	"github.com/spf13/cobra"
@@ -36,7 +37,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 
 			cfg.Aura.BindOutput(cmd.Flags().Lookup("output"))
 
-			return nil
+			return output.ValidateTemplate(cmd, cfg)
 		},
 	}
 
@@ -48,6 +49,7 @@ func NewCmd(cfg *clicfg.Config) *cobra.Command {
 	cmd.AddCommand(NewResumeCmd(cfg))
 	cmd.AddCommand(NewUpdateCmd(cfg))
 	cmd.AddCommand(NewOverwriteCmd(cfg))
+	cmd.AddCommand(NewWaitCmd(cfg))
 	cmd.AddCommand(snapshot.NewCmd(cfg))
 
 	cmd.PersistentFlags().String("auth-url", "", "")
@@ -5,6 +5,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -27,7 +29,7 @@ You can filter instances in a particular tenant using --tenant-id. If the tenant
 			}
 
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method:      http.MethodGet,
 				QueryParams: queryParams,
 			})
@@ -36,13 +38,19 @@ You can filter instances in a particular tenant using --tenant-id. If the tenant
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "cloud_provider"})
+				if err := output.PrintList(cmd, cfg, resBody, output.DefaultColumns(cfg, "instance", []string{"id", "name", "tenant_id", "cloud_provider"})); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&tenantId, "tenant-id", "", "An optional Tenant ID to filter instances in a tenant")
+	cmd.RegisterFlagCompletionFunc("tenant-id", completion.Tenants(cfg))
+
+	flags.AddFilterFlags(cmd)
+	flags.AddNameFilterFlag(cmd)
 
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -75,6 +76,505 @@ func TestListInstances(t *testing.T) {
 	}`)
 }
 
+func TestListInstancesWithYamlOutput(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.SetConfigValue("aura.output", "yaml")
+
+	mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+			"data": [
+				{
+					"id": "2f49c2b3",
+					"name": "Production",
+					"tenant_id": "YOUR_TENANT_ID",
+					"cloud_provider": "gcp"
+				},
+				{
+					"id": "b51dc964",
+					"name": "Instance01",
+					"tenant_id": "YOUR_TENANT_ID",
+					"cloud_provider": "aws"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand("instance list")
+
+	mockHandler.AssertCalledTimes(1)
+
+	helper.AssertOut(`
+data:
+  - cloud_provider: gcp
+    id: 2f49c2b3
+    name: Production
+    tenant_id: YOUR_TENANT_ID
+  - cloud_provider: aws
+    id: b51dc964
+    name: Instance01
+    tenant_id: YOUR_TENANT_ID
+`)
+}
+
+func TestListInstancesWithDelimitedOutput(t *testing.T) {
+	tests := map[string]struct {
+		executeCommand   string
+		expectedResponse string
+	}{
+		"csv": {
+			executeCommand: "instance list --output csv",
+			expectedResponse: `id,name,tenant_id,cloud_provider
+2f49c2b3,"Production, EU",YOUR_TENANT_ID,gcp
+b51dc964,Instance01,YOUR_TENANT_ID,aws`,
+		},
+		"tsv": {
+			executeCommand: "instance list --output tsv",
+			expectedResponse: "id\tname\ttenant_id\tcloud_provider\n" +
+				"2f49c2b3\tProduction, EU\tYOUR_TENANT_ID\tgcp\n" +
+				"b51dc964\tInstance01\tYOUR_TENANT_ID\taws",
+		},
+		"csv without headers": {
+			executeCommand: "instance list --output csv --no-headers",
+			expectedResponse: `2f49c2b3,"Production, EU",YOUR_TENANT_ID,gcp
+b51dc964,Instance01,YOUR_TENANT_ID,aws`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production, EU",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp"
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "aws"
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand(tt.executeCommand)
+
+			mockHandler.AssertCalledTimes(1)
+
+			helper.AssertOut(tt.expectedResponse)
+		})
+	}
+}
+
+func TestListInstancesWithTableOutputWithoutHeaders(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+			"data": [
+				{
+					"id": "2f49c2b3",
+					"name": "Production",
+					"tenant_id": "YOUR_TENANT_ID",
+					"cloud_provider": "gcp"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand("instance list --output table --no-headers")
+
+	helper.AssertOut(`
+┌──────────┬────────────┬────────────────┬─────┐
+│ 2f49c2b3 │ Production │ YOUR_TENANT_ID │ gcp │
+└──────────┴────────────┴────────────────┴─────┘`)
+}
+
+func TestListInstancesWithQuery(t *testing.T) {
+	tests := map[string]struct {
+		executeCommand   string
+		expectedResponse string
+	}{
+		"ids as json": {
+			executeCommand: `instance list --query 'data.#(cloud_provider=="gcp")#.id'`,
+			expectedResponse: `[
+	"2f49c2b3",
+	"524b7d8d"
+]`,
+		},
+		"ids as text": {
+			executeCommand: `instance list --output table --query 'data.#(cloud_provider=="gcp")#.id'`,
+			expectedResponse: `2f49c2b3
+524b7d8d`,
+		},
+		"objects as csv": {
+			executeCommand: `instance list --output csv --query 'data.#(cloud_provider=="gcp")#'`,
+			expectedResponse: `id,name,tenant_id,cloud_provider
+2f49c2b3,Production,YOUR_TENANT_ID,gcp
+524b7d8d,Northwind,YOUR_TENANT_ID,gcp`,
+		},
+		"single value as yaml": {
+			executeCommand:   "instance list --output yaml --query data.0.name",
+			expectedResponse: "Production",
+		},
+		"no match": {
+			executeCommand:   `instance list --query 'data.#(cloud_provider=="azure")#.id'`,
+			expectedResponse: "[]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp"
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "aws"
+					},
+					{
+						"id": "524b7d8d",
+						"name": "Northwind",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp"
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand(tt.executeCommand)
+
+			helper.AssertOut(tt.expectedResponse)
+		})
+	}
+}
+
+func TestListInstancesWithTemplate(t *testing.T) {
+	tests := map[string]struct {
+		executeCommand   string
+		expectedResponse string
+	}{
+		"template": {
+			executeCommand: `instance list --output template --template '{{range .}}{{.id}} {{.name}}{{"\n"}}{{end}}'`,
+			expectedResponse: `2f49c2b3 Production
+b51dc964 Instance01`,
+		},
+		"template with padding": {
+			executeCommand: `instance list --output template --template '{{range .}}{{.name | pad 12}}{{.cloud_provider | padLeft 4}}{{"\n"}}{{end}}'`,
+			expectedResponse: `Production   gcp
+Instance01   aws`,
+		},
+		"template over query result": {
+			executeCommand:   `instance list --output template --query 'data.#(cloud_provider=="aws")#' --template '{{range .}}{{.id}}{{"\n"}}{{end}}'`,
+			expectedResponse: `b51dc964`,
+		},
+		"template file": {
+			executeCommand: "instance list --output template --template-file list.tmpl",
+			expectedResponse: `{"cloud_provider":"gcp","id":"2f49c2b3","name":"Production","tenant_id":"YOUR_TENANT_ID"}
+{"cloud_provider":"aws","id":"b51dc964","name":"Instance01","tenant_id":"YOUR_TENANT_ID"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.SetFile("list.tmpl", `{{range .}}{{json .}}{{"\n"}}{{end}}`)
+
+			helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp"
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "aws"
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand(tt.executeCommand)
+
+			helper.AssertOut(tt.expectedResponse)
+		})
+	}
+}
+
+func TestListInstancesWithInvalidTemplate(t *testing.T) {
+	tests := map[string]struct {
+		executeCommand  string
+		expectedMessage string
+	}{
+		"missing template": {
+			executeCommand:  "instance list --output template",
+			expectedMessage: "the template output format requires --template or --template-file",
+		},
+		"template without template output": {
+			executeCommand:  "instance list --output table --template '{{.}}'",
+			expectedMessage: "--template and --template-file require the template output format",
+		},
+		"unparsable template": {
+			executeCommand:  "instance list --output template --template '{{range .}}'",
+			expectedMessage: "invalid template: template: output:1: unexpected EOF",
+		},
+		"missing template file": {
+			executeCommand:  "instance list --output template --template-file missing.tmpl",
+			expectedMessage: "cannot read template file: open missing.tmpl: file does not exist",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{"data": []}`)
+
+			helper.ExecuteCommand(tt.executeCommand)
+
+			mockHandler.AssertCalledTimes(0)
+
+			helper.AssertErr("Error: " + tt.expectedMessage)
+			helper.AssertExitCode(clierr.ExitCodeUsage)
+		})
+	}
+}
+
+func TestListInstancesWithColumns(t *testing.T) {
+	tests := map[string]struct {
+		executeCommand   string
+		columnsConfig    string
+		expectedResponse string
+	}{
+		"columns": {
+			executeCommand: "instance list --output csv --columns id,status,memory",
+			expectedResponse: `id,status,memory
+2f49c2b3,running,8GB
+b51dc964,paused,
+432392ae,running,2GB`,
+		},
+		"wide": {
+			executeCommand: "instance list --output csv --wide",
+			expectedResponse: `id,name,tenant_id,cloud_provider,graph_nodes,memory,status
+2f49c2b3,Production,YOUR_TENANT_ID,gcp,1500,8GB,running
+b51dc964,Instance01,YOUR_TENANT_ID,aws,,,paused
+432392ae,Recommendations,YOUR_TENANT_ID,azure,200,2GB,running`,
+		},
+		"sort by text": {
+			executeCommand: "instance list --output csv --sort-by name",
+			expectedResponse: `id,name,tenant_id,cloud_provider
+b51dc964,Instance01,YOUR_TENANT_ID,aws
+2f49c2b3,Production,YOUR_TENANT_ID,gcp
+432392ae,Recommendations,YOUR_TENANT_ID,azure`,
+		},
+		"sort by number with missing values last": {
+			executeCommand: "instance list --output csv --columns id,graph_nodes --sort-by graph_nodes",
+			expectedResponse: `id,graph_nodes
+432392ae,200
+2f49c2b3,1500
+b51dc964,`,
+		},
+		"configured columns": {
+			executeCommand: "instance list --output csv",
+			columnsConfig:  "id, status",
+			expectedResponse: `id,status
+2f49c2b3,running
+b51dc964,paused
+432392ae,running`,
+		},
+		"columns flag over configured columns": {
+			executeCommand: "instance list --output csv --columns name",
+			columnsConfig:  "id,status",
+			expectedResponse: `name
+Production
+Instance01
+Recommendations`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			if tt.columnsConfig != "" {
+				helper.SetConfigValue("aura.columns.instance", tt.columnsConfig)
+			}
+
+			helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "Production",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "gcp",
+						"status": "running",
+						"memory": "8GB",
+						"graph_nodes": 1500
+					},
+					{
+						"id": "b51dc964",
+						"name": "Instance01",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "aws",
+						"status": "paused"
+					},
+					{
+						"id": "432392ae",
+						"name": "Recommendations",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "azure",
+						"status": "running",
+						"memory": "2GB",
+						"graph_nodes": 200
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand(tt.executeCommand)
+
+			helper.AssertOut(tt.expectedResponse)
+		})
+	}
+}
+
+func TestListInstancesWithFilters(t *testing.T) {
+	tests := map[string]struct {
+		executeCommand   string
+		expectedResponse string
+	}{
+		"equal": {
+			executeCommand: "instance list --output csv --no-headers --filter status=running",
+			expectedResponse: `2f49c2b3,prod-eu,YOUR_TENANT_ID,gcp
+432392ae,prod-us,YOUR_TENANT_ID,azure`,
+		},
+		"not equal": {
+			executeCommand:   "instance list --output csv --no-headers --filter 'status!=running'",
+			expectedResponse: `b51dc964,staging,YOUR_TENANT_ID,aws`,
+		},
+		"wildcard value": {
+			executeCommand: "instance list --output csv --no-headers --filter 'memory=*GB'",
+			expectedResponse: `2f49c2b3,prod-eu,YOUR_TENANT_ID,gcp
+432392ae,prod-us,YOUR_TENANT_ID,azure`,
+		},
+		"number value": {
+			executeCommand:   "instance list --output csv --no-headers --filter graph_nodes=200",
+			expectedResponse: `432392ae,prod-us,YOUR_TENANT_ID,azure`,
+		},
+		"name pattern": {
+			executeCommand: "instance list --output csv --no-headers --name 'prod-*'",
+			expectedResponse: `2f49c2b3,prod-eu,YOUR_TENANT_ID,gcp
+432392ae,prod-us,YOUR_TENANT_ID,azure`,
+		},
+		"repeated filters": {
+			executeCommand:   "instance list --output csv --no-headers --name 'prod-*' --filter cloud_provider=gcp",
+			expectedResponse: `2f49c2b3,prod-eu,YOUR_TENANT_ID,gcp`,
+		},
+		"wildcard matching slashes": {
+			executeCommand:   "instance list --output csv --no-headers --filter 'connection_url=neo4j+s://432*'",
+			expectedResponse: `432392ae,prod-us,YOUR_TENANT_ID,azure`,
+		},
+		"character class": {
+			executeCommand:   "instance list --output csv --no-headers --name 'prod-[!e]?'",
+			expectedResponse: `432392ae,prod-us,YOUR_TENANT_ID,azure`,
+		},
+		"no match": {
+			executeCommand:   "instance list --filter cloud_provider=oracle",
+			expectedResponse: `{"data": []}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{
+				"data": [
+					{
+						"id": "2f49c2b3",
+						"name": "prod-eu",
+						"tenant_id": "YOUR_TENANT_ID",
+						"connection_url": "neo4j+s://2f49c2b3.databases.neo4j.io",
+						"cloud_provider": "gcp",
+						"status": "running",
+						"memory": "8GB",
+						"graph_nodes": 1500
+					},
+					{
+						"id": "b51dc964",
+						"name": "staging",
+						"tenant_id": "YOUR_TENANT_ID",
+						"cloud_provider": "aws",
+						"status": "paused"
+					},
+					{
+						"id": "432392ae",
+						"name": "prod-us",
+						"tenant_id": "YOUR_TENANT_ID",
+						"connection_url": "neo4j+s://432392ae.databases.neo4j.io",
+						"cloud_provider": "azure",
+						"status": "running",
+						"memory": "2GB",
+						"graph_nodes": 200
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand(tt.executeCommand)
+
+			if tt.expectedResponse == `{"data": []}` {
+				helper.AssertOutJson(tt.expectedResponse)
+			} else {
+				helper.AssertOut(tt.expectedResponse)
+			}
+		})
+	}
+}
+
+func TestListInstancesWithInvalidFilter(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --filter status")
+
+	helper.AssertErrMessage(`invalid filter "status", expected key=value or key!=value`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
+func TestListInstancesWithInvalidFilterPattern(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("/v1/instances", http.StatusOK, `{"data": []}`)
+
+	helper.ExecuteCommand("instance list --filter 'name=prod-[eu'")
+
+	helper.AssertErrMessage(`invalid pattern "prod-[eu": unterminated character class`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
 func TestListInstancesWithTenantId(t *testing.T) {
 	helper := testutils.NewAuraTestHelper(t)
 	defer helper.Close()
@@ -150,5 +650,5 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.ExecuteCommand("instance list --output invalid")
 
-	helper.AssertErr("Error: invalid output value specified: invalid")
+	helper.AssertErrMessage("invalid output value specified: invalid")
 }
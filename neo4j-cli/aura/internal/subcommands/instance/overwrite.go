@@ -6,6 +6,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -31,16 +33,24 @@ The overwrite process mimics the 'Clone to existing' functionality of the Aura C
 
 If only --source-instance-id is provided, a new snapshot of that instance is created and used for overwriting. Alternatively, you can specify an additional --source-snapshot-id to use a specific snapshot for overwriting, from --source-instance-id provided, otherwise as a snapshot of the instance being overwritten. The snapshot specified must be exportable.
 		`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.InstanceArg(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			instanceId := args[0]
-			path := fmt.Sprintf("/instances/%s/overwrite", instanceId)
-
 			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/overwrite", instanceId)
 
 			postBody := make(map[string]any)
 			if sourceInstanceId == "" {
 				sourceInstanceId = instanceId
+			} else {
+				sourceInstanceId, err = api.ResolveInstanceId(cmd.Context(), cfg, sourceInstanceId)
+				if err != nil {
+					return err
+				}
 			}
 			postBody["source_instance_id"] = sourceInstanceId
 
@@ -48,7 +58,7 @@ If only --source-instance-id is provided, a new snapshot of that instance is cre
 				postBody["source_snapshot_id"] = sourceSnapshotId
 			}
 
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method:   http.MethodPost,
 				PostBody: postBody,
 			})
@@ -57,12 +67,15 @@ If only --source-instance-id is provided, a new snapshot of that instance is cre
 			}
 
 			if statusCode == http.StatusAccepted {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage", "customer_managed_key_id"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory", "storage", "customer_managed_key_id"}); err != nil {
+					return err
+				}
 			}
 
 			if await {
+				flags.BindPollingFlags(cmd, cfg)
 				cmd.Println("Waiting for instance to be ready...")
-				pollResponse, err := api.PollInstance(cfg, instanceId, api.InstanceStatusOverwriting)
+				pollResponse, err := api.PollInstance(cmd.Context(), cfg, instanceId, api.UntilStatus(api.InstanceSuccessStatus...))
 				if err != nil {
 					return err
 				}
@@ -75,11 +88,14 @@ If only --source-instance-id is provided, a new snapshot of that instance is cre
 	}
 
 	cmd.Flags().StringVar(&sourceInstanceId, sourceInstanceIdFlag, "", "The ID of the instance to overwrite with, from the source snapshot ID if provided, otherwise takes a new snapshot and overwrites")
+	cmd.RegisterFlagCompletionFunc(sourceInstanceIdFlag, completion.Instances(cfg))
 	cmd.Flags().StringVar(&sourceSnapshotId, sourceSnapshotIdFlag, "", "The ID of the snapshot to overwrite with, which must be exportable, from the source instance ID if provided, otherwise the argument provided instance")
+	cmd.RegisterFlagCompletionFunc(sourceSnapshotIdFlag, completion.Snapshots(cfg, sourceInstanceIdFlag))
 
 	cmd.MarkFlagsOneRequired(sourceInstanceIdFlag, sourceSnapshotIdFlag)
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until created snapshot is ready")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
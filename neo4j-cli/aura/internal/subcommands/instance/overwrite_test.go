@@ -123,7 +123,7 @@ func TestOverwriteWithAwait(t *testing.T) {
 	}`).AddResponse(http.StatusOK, `{
 		"data": {
 			"id": "2f49c2b3",
-			"status": "ready"
+			"status": "running"
 		}
 	}`)
 
@@ -136,7 +136,8 @@ func TestOverwriteWithAwait(t *testing.T) {
 
 	getMock.AssertCalledTimes(2)
 
-	helper.AsssertOk()
+	helper.AssertErr(`{"event":"status","path":"/instances/2f49c2b3","status":"overwriting","elapsed_seconds":0}
+{"event":"status","path":"/instances/2f49c2b3","status":"running","previous_status":"overwriting","elapsed_seconds":0}`)
 
 	helper.AssertOut(`{
 	"data": {
@@ -152,6 +153,6 @@ func TestOverwriteWithAwait(t *testing.T) {
 	}
 }
 Waiting for instance to be ready...
-Instance Status: ready
+Instance Status: running
 	  `)
 }
@@ -56,7 +56,7 @@ func TestPauseInstanceError(t *testing.T) {
 	}{
 		{
 			statusCode:    http.StatusNotFound,
-			expectedError: "Error: [DB not found: 24d18db5]",
+			expectedError: "[DB not found: 24d18db5]",
 			returnBody: `{
 			"errors": [
 			  {
@@ -68,7 +68,7 @@ func TestPauseInstanceError(t *testing.T) {
 		},
 		{
 			statusCode:    http.StatusConflict,
-			expectedError: "Error: [The database is current undergoing an operation: resuming]",
+			expectedError: "[The database is current undergoing an operation: resuming]",
 			returnBody: `{
 				"errors": [
 				  {
@@ -95,7 +95,7 @@ func TestPauseInstanceError(t *testing.T) {
 			mockHandler.AssertCalledWithMethod(http.MethodPost)
 
 			helper.AssertOut("")
-			helper.AssertErr(testCase.expectedError)
+			helper.AssertErrMessage(testCase.expectedError)
 		})
 	}
 }
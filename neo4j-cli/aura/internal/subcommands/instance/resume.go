@@ -7,6 +7,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -28,12 +30,17 @@ func NewResumeCmd(cfg *clicfg.Config) *cobra.Command {
 Resuming an instance is an asynchronous operation. You can poll the current status of this operation by periodically getting the instance details for the instance ID using the get subcommand.
 
 If another operation is being performed on the instance you are trying to resume, an error will be returned that indicates that resume cannot be performed.`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.InstanceArg(cfg),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			path := fmt.Sprintf("/instances/%s/resume", args[0])
-
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+			path := fmt.Sprintf("/instances/%s/resume", instanceId)
+
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
 			if err != nil {
@@ -42,16 +49,19 @@ If another operation is being performed on the instance you are trying to resume
 
 			// NOTE: Instance resume should not return OK (200), it always returns 202
 			if statusCode == http.StatusAccepted || statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"id", "name", "tenant_id", "status", "connection_url", "cloud_provider", "region", "type", "memory"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for instance to be ready...")
 					var response api.CreateInstanceResponse
 					if err := json.Unmarshal(resBody, &response); err != nil {
 						return err
 					}
 
-					pollResponse, err := api.PollInstance(cfg, response.Data.Id, api.InstanceStatusResuming)
+					pollResponse, err := api.PollInstance(cmd.Context(), cfg, response.Data.Id, api.UntilStatus(api.InstanceSuccessStatus...))
 					if err != nil {
 						return err
 					}
@@ -64,5 +74,6 @@ If another operation is being performed on the instance you are trying to resume
 	}
 
 	cmd.Flags().BoolVar(&await, awaitFlag, false, "Waits until resumed instance is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 	return cmd
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/neo4j/cli/common/clicfg"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -23,9 +25,13 @@ Creating a snapshot is an asynchronous operation. You can poll the current statu
 The time taken to complete a snapshot depends on the amount of data stored in the instance; larger quantities of data will take longer. The exact time this will take is dependent on the size of your data store.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, instanceId)
+			if err != nil {
+				return err
+			}
 			path := fmt.Sprintf("/instances/%s/snapshots", instanceId)
 
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodPost,
 			})
 
@@ -34,9 +40,12 @@ The time taken to complete a snapshot depends on the amount of data stored in th
 			}
 
 			if statusCode == http.StatusAccepted {
-				output.PrintBody(cmd, cfg, resBody, []string{"snapshot_id"})
+				if err := output.PrintBody(cmd, cfg, resBody, []string{"snapshot_id"}); err != nil {
+					return err
+				}
 
 				if await {
+					flags.BindPollingFlags(cmd, cfg)
 					cmd.Println("Waiting for snapshot to be ready...")
 					var response api.CreateSnapshotResponse
 					if err := json.Unmarshal(resBody, &response); err != nil {
@@ -44,7 +53,7 @@ The time taken to complete a snapshot depends on the amount of data stored in th
 					}
 
 					// Snapshot is not ready after pending
-					pollResponse, err := api.PollSnapshot(cfg, instanceId, response.Data.SnapshotId)
+					pollResponse, err := api.PollSnapshot(cmd.Context(), cfg, instanceId, response.Data.SnapshotId, api.UntilStatus(api.SnapshotSuccessStatus...))
 					if err != nil {
 						return err
 					}
@@ -57,9 +66,11 @@ The time taken to complete a snapshot depends on the amount of data stored in th
 	}
 
 	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance to create a snapshot of")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
 	cmd.MarkFlagRequired("instance-id")
 
 	cmd.Flags().BoolVar(&await, "await", false, "Waits until created snapshot is ready.")
+	flags.AddPollingFlags(cmd, cfg)
 
 	return cmd
 }
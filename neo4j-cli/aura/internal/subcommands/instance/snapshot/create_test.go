@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -65,7 +66,9 @@ func TestCreateSnapshotWithAwait(t *testing.T) {
 	getMock.AssertCalledTimes(3)
 	getMock.AssertCalledWithMethod(http.MethodGet)
 
-	helper.AssertErr("")
+	helper.AssertErr(`{"event":"status","path":"/instances/2f49c2b3/snapshots/snap123","status":"Pending","elapsed_seconds":0}
+{"event":"status","path":"/instances/2f49c2b3/snapshots/snap123","status":"InProgress","previous_status":"Pending","elapsed_seconds":0}
+{"event":"status","path":"/instances/2f49c2b3/snapshots/snap123","status":"Completed","previous_status":"InProgress","elapsed_seconds":0}`)
 	helper.AssertOut(`
 {
 	"data": {
@@ -76,3 +79,33 @@ Waiting for snapshot to be ready...
 Snapshot Status: Completed
 	`)
 }
+
+func TestCreateSnapshotWithAwaitFailed(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("POST /v1/instances/%s/snapshots", instanceId), http.StatusAccepted, `{
+		"data": {
+		  "snapshot_id": "snap123"
+		}
+	  }`)
+
+	getMock := helper.NewRequestHandlerMock(fmt.Sprintf("GET /v1/instances/%s/snapshots/snap123", instanceId), http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "InProgress"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "db1d1234",
+				"status": "Failed"
+			}
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance snapshot create --instance-id %s --await", instanceId))
+
+	getMock.AssertCalledTimes(2)
+
+	helper.AssertErrMessage(`snapshot snap123 ended in failure status "Failed" while waiting for "Completed"`)
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
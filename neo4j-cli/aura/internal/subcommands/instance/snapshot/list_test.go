@@ -82,3 +82,38 @@ func TestListSnapshotWithDate(t *testing.T) {
 	}
 	`)
 }
+
+func TestListSnapshotWithFilter(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+	instanceId := "2f49c2b3"
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/instances/%s/snapshots", instanceId), http.StatusOK, `{
+		"data": [
+			{
+				"instance_id": "2f49c2b3",
+				"profile": "AdHoc",
+				"snapshot_id": "afdb4e9d",
+				"status": "Completed"
+			},
+			{
+				"instance_id": "2f49c2b3",
+				"profile": "Scheduled",
+				"snapshot_id": "b0e1c2d3",
+				"status": "Failed"
+			}
+		]
+	}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("instance snapshot list --instance-id %s --filter 'status!=Failed'", instanceId))
+
+	helper.AssertOutJson(`{
+		"data": [
+			{
+				"instance_id": "2f49c2b3",
+				"profile": "AdHoc",
+				"snapshot_id": "afdb4e9d",
+				"status": "Completed"
+			}
+		]
+	}`)
+}
@@ -0,0 +1,46 @@
+package snapshot
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewWaitCmd(cfg *clicfg.Config) *cobra.Command {
+	var instanceId string
+
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Waits for a snapshot to reach a status",
+		Long: `Waits until a snapshot reaches the given status, Completed by default, or until it is deleted when using --for-deletion.
+
+The command fails early if the snapshot ends in a failure status.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			flags.BindPollingFlags(cmd, cfg)
+
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, instanceId)
+			if err != nil {
+				return err
+			}
+
+			pollResponse, err := api.PollSnapshot(cmd.Context(), cfg, instanceId, args[0], flags.WaitUntil(cmd))
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Snapshot Status:", pollResponse.Data.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&instanceId, "instance-id", "", "(required) The ID of the instance the snapshot belongs to")
+	cmd.RegisterFlagCompletionFunc("instance-id", completion.Instances(cfg))
+	cmd.MarkFlagRequired("instance-id")
+
+	flags.AddWaitFlags(cmd, cfg, api.SnapshotStatusCompleted, api.SnapshotStatuses)
+	return cmd
+}
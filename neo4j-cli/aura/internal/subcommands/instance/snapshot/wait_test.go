@@ -0,0 +1,63 @@
+package snapshot_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestWaitSnapshot(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/snap123", http.StatusOK, `{
+			"data": {
+				"snapshot_id": "snap123",
+				"status": "InProgress"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"snapshot_id": "snap123",
+				"status": "Completed"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance snapshot wait snap123 --instance-id 2f49c2b3")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOut("Snapshot Status: Completed")
+}
+
+func TestWaitSnapshotFailed(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/snap123", http.StatusOK, `{
+			"data": {
+				"snapshot_id": "snap123",
+				"status": "Failed"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance snapshot wait snap123 --instance-id 2f49c2b3")
+
+	helper.AssertErrMessage(`snapshot snap123 ended in failure status "Failed" while waiting for "Completed"`)
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
+
+func TestWaitSnapshotForUnknownStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3/snapshots/snap123", http.StatusOK, `{"data": {"snapshot_id": "snap123", "status": "Completed"}}`)
+
+	helper.ExecuteCommand("instance snapshot wait snap123 --instance-id 2f49c2b3 --for-status completed")
+
+	mockHandler.AssertCalledTimes(0)
+
+	helper.AssertErrMessage(`invalid argument "completed" for "--for-status" flag: must be one of "Pending", "InProgress", "Completed", or "Failed"`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
@@ -143,7 +143,7 @@ func TestUpdateErrorsWithNoFlags(t *testing.T) {
 
 	mockHandler.AssertCalledTimes(0)
 
-	helper.AssertErr(`Error: at least one of the flags in the group [memory name] is required
+	helper.AssertErrMessage(`at least one of the flags in the group [memory name] is required
 `)
 }
 
@@ -155,7 +155,7 @@ func TestUpdateInstanceError(t *testing.T) {
 	}{
 		{
 			statusCode:    http.StatusNotFound,
-			expectedError: "Error: [DB not found: 24d18db5]",
+			expectedError: "[DB not found: 24d18db5]",
 			returnBody: `{
 			"errors": [
 			  {
@@ -167,7 +167,7 @@ func TestUpdateInstanceError(t *testing.T) {
 		},
 		{
 			statusCode:    http.StatusConflict,
-			expectedError: "Error: [The database is current undergoing an operation: resuming]",
+			expectedError: "[The database is current undergoing an operation: resuming]",
 			returnBody: `{
 				"errors": [
 				  {
@@ -194,7 +194,7 @@ func TestUpdateInstanceError(t *testing.T) {
 			mockHandler.AssertCalledWithMethod(http.MethodPatch)
 
 			helper.AssertOut("")
-			helper.AssertErr(testCase.expectedError)
+			helper.AssertErrMessage(testCase.expectedError)
 		})
 	}
 }
@@ -0,0 +1,41 @@
+package instance
+
+import (
+	"github.com/neo4j/cli/common/clicfg"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/api"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/completion"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewWaitCmd(cfg *clicfg.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Waits for an instance to reach a status",
+		Long: `Waits until an instance reaches the given status, running by default, or until it is deleted when using --for-deletion.
+
+The command fails early if the instance ends in a failure status.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.InstanceArg(cfg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			flags.BindPollingFlags(cmd, cfg)
+
+			instanceId, err := api.ResolveInstanceId(cmd.Context(), cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			pollResponse, err := api.PollInstance(cmd.Context(), cfg, instanceId, flags.WaitUntil(cmd))
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Instance Status:", pollResponse.Data.Status)
+			return nil
+		},
+	}
+
+	flags.AddWaitFlags(cmd, cfg, api.InstanceStatusRunning, api.InstanceStatuses)
+	return cmd
+}
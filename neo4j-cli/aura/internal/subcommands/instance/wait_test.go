@@ -0,0 +1,159 @@
+package instance_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/neo4j/cli/common/clierr"
+	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
+)
+
+func TestWaitInstance(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"status": "creating"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"status": "running"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3")
+
+	mockHandler.AssertCalledTimes(2)
+	mockHandler.AssertCalledWithMethod(http.MethodGet)
+
+	helper.AssertOut("Instance Status: running")
+}
+
+func TestWaitInstanceForStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"status": "pausing"
+			}
+		}`).AddResponse(http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"status": "paused"
+			}
+		}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3 --for-status paused")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertOut("Instance Status: paused")
+}
+
+func TestWaitInstanceForDeletion(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{
+			"data": {
+				"id": "2f49c2b3",
+				"status": "destroying"
+			}
+		}`).AddResponse(http.StatusNotFound, `{
+			"errors": [
+				{
+					"message": "DB not found: 2f49c2b3",
+					"reason": "db-not-found"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3 --for-deletion")
+
+	mockHandler.AssertCalledTimes(2)
+
+	helper.AssertErr(`{"event":"status","path":"/instances/2f49c2b3","status":"destroying","elapsed_seconds":0}
+{"event":"status","path":"/instances/2f49c2b3","status":"deleted","previous_status":"destroying","elapsed_seconds":0}`)
+	helper.AssertOut("Instance Status: deleted")
+}
+
+func TestWaitInstanceNotFound(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusNotFound, `{
+			"errors": [
+				{
+					"message": "DB not found: 2f49c2b3",
+					"reason": "db-not-found"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand("instance wait 2f49c2b3")
+
+	helper.AssertErrMessage("error polling: [DB not found: 2f49c2b3]")
+	helper.AssertExitCode(clierr.ExitCodeNotFound)
+}
+
+func TestWaitInstanceWithStatusAndDeletion(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("instance wait 2f49c2b3 --for-status paused --for-deletion")
+
+	helper.AssertErrMessage("if any flags in the group [for-status for-deletion] are set none of the others can be; [for-deletion for-status] were all set")
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
+func TestWaitInstanceForUnknownStatus(t *testing.T) {
+	tests := map[string]struct {
+		status string
+	}{
+		"different case": {status: "Running"},
+		"typo":           {status: "runing"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			mockHandler := helper.NewRequestHandlerMock("GET /v1/instances/2f49c2b3", http.StatusOK, `{"data": {"id": "2f49c2b3", "status": "running"}}`)
+
+			helper.ExecuteCommand("instance wait 2f49c2b3 --for-status " + tt.status)
+
+			mockHandler.AssertCalledTimes(0)
+
+			helper.AssertErrMessage(`invalid argument "` + tt.status + `" for "--for-status" flag: must be one of "creating", "destroying", "running", "pausing", "paused", "suspending", "suspended", "resuming", "loading", "loading failed", "restoring", "updating", or "overwriting"`)
+			helper.AssertExitCode(clierr.ExitCodeUsage)
+		})
+	}
+}
+
+func TestCompleteWaitInstanceForStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	helper.ExecuteCommand("__complete instance wait 2f49c2b3 --for-status ''")
+
+	helper.AssertOut(`creating
+destroying
+running
+pausing
+paused
+suspending
+suspended
+resuming
+loading
+loading failed
+restoring
+updating
+overwriting
+:4`)
+}
@@ -1,6 +1,7 @@
 package tenant
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -23,7 +24,7 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			path := fmt.Sprintf("/tenants/%s", tenantId)
 
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, path, &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, path, &api.RequestConfig{
 				Method: http.MethodGet,
 			})
 			if err != nil {
@@ -31,14 +32,16 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				responseData := api.ParseBody(resBody)
-				fields, values, err := postProcessResponseValues(cfg, tenantId, responseData)
+				responseData, err := api.ParseBody(resBody)
 				if err != nil {
 					return err
 				}
-				output.PrintBodyMap(cmd, cfg, values, fields)
-				if cfg.Aura.Output() == "table" || cfg.Aura.Output() == "default" {
-					cmd.Println("instance configurations are not visible with table output - please use a different output setting using --output if you would like to view these")
+				fields, values, err := postProcessResponseValues(cmd.Context(), cfg, tenantId, responseData)
+				if err != nil {
+					return err
+				}
+				if err := output.PrintBodyMap(cmd, cfg, values, output.DefaultColumns(cfg, "tenant", append(fields, "instance_configurations"))); err != nil {
+					return err
 				}
 			}
 
@@ -47,8 +50,8 @@ func NewGetCmd(cfg *clicfg.Config) *cobra.Command {
 	}
 }
 
-func postProcessResponseValues(cfg *clicfg.Config, tenantId string, responseData api.ResponseData) ([]string, api.ResponseData, error) {
-	metricsIntegrationEndpointUrl, err := getMetricsIntegrationEndpointUrl(cfg, tenantId)
+func postProcessResponseValues(ctx context.Context, cfg *clicfg.Config, tenantId string, responseData api.ResponseData) ([]string, api.ResponseData, error) {
+	metricsIntegrationEndpointUrl, err := getMetricsIntegrationEndpointUrl(ctx, cfg, tenantId)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -65,8 +68,8 @@ func postProcessResponseValues(cfg *clicfg.Config, tenantId string, responseData
 	}
 }
 
-func getMetricsIntegrationEndpointUrl(cfg *clicfg.Config, tenantId string) (string, error) {
-	resBody, statusCode, err := api.MakeRequest(cfg, fmt.Sprintf("/tenants/%s/metrics-integration", tenantId), &api.RequestConfig{
+func getMetricsIntegrationEndpointUrl(ctx context.Context, cfg *clicfg.Config, tenantId string) (string, error) {
+	resBody, statusCode, err := api.MakeRequest(ctx, cfg, fmt.Sprintf("/tenants/%s/metrics-integration", tenantId), &api.RequestConfig{
 		Method: http.MethodGet,
 	})
 	// Aura API (in fact Console API returns HTTP 400 when CMI endpoint is not available for the tenant)
@@ -75,7 +78,10 @@ func getMetricsIntegrationEndpointUrl(cfg *clicfg.Config, tenantId string) (stri
 	}
 	switch {
 	case statusCode == http.StatusOK:
-		metricsIntegrationResponse := api.ParseBody(resBody)
+		metricsIntegrationResponse, err := api.ParseBody(resBody)
+		if err != nil {
+			return "", err
+		}
 		metricsIntegration, err := metricsIntegrationResponse.GetSingleOrError()
 		if err != nil {
 			return "", err
@@ -89,6 +95,6 @@ func getMetricsIntegrationEndpointUrl(cfg *clicfg.Config, tenantId string) (stri
 	case statusCode == http.StatusBadRequest:
 		return "", nil
 	default:
-		panic(clierr.NewFatalError("unexpected statusCode %d", statusCode))
+		return "", clierr.NewUpstreamError("unexpected status code %d getting the metrics integration endpoint", statusCode).WithStatusCode(statusCode)
 	}
 }
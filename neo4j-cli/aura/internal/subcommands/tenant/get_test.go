@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/neo4j/cli/common/clierr"
 	"github.com/neo4j/cli/neo4j-cli/aura/internal/test/testutils"
 )
 
@@ -106,7 +107,7 @@ func TestGetTenantNotFoundError(t *testing.T) {
 	mockHandler.AssertCalledTimes(1)
 	mockHandler.AssertCalledWithMethod(http.MethodGet)
 
-	helper.AssertErr("Error: [The tenant you specified could not be found]")
+	helper.AssertErrMessage("[The tenant you specified could not be found]")
 }
 
 func TestGetTenantWithTableOutput(t *testing.T) {
@@ -119,7 +120,26 @@ func TestGetTenantWithTableOutput(t *testing.T) {
 			"data": {
 				"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
 				"name": "Production",
-				"instance_configurations": []
+				"instance_configurations": [
+					{
+						"cloud_provider": "gcp",
+						"memory": "8GB",
+						"region": "europe-west1",
+						"region_name": "Belgium (europe-west1)",
+						"storage": "16GB",
+						"type": "enterprise-db",
+						"version": "5"
+					},
+					{
+						"cloud_provider": "aws",
+						"memory": "2GB",
+						"region": "us-east-1",
+						"region_name": "N. Virginia (us-east-1)",
+						"storage": "4GB",
+						"type": "professional-db",
+						"version": "5"
+					}
+				]
 			}
 		}`)
 
@@ -140,11 +160,131 @@ func TestGetTenantWithTableOutput(t *testing.T) {
 	metricsIntegrationMockHandler.AssertCalledWithMethod(http.MethodGet)
 
 	helper.AssertOut(`
-┌──────────────────────────────────────┬────────────┐
-│ ID                                   │ NAME       │
-├──────────────────────────────────────┼────────────┤
-│ 6981ace7-efe8-4f5c-b7c5-267b5162ce91 │ Production │
-└──────────────────────────────────────┴────────────┘
-instance configurations are not visible with table output - please use a different output setting using --output if you would like to view these
+┌──────────────────────────────────────┬────────────┬────────────────────────────────────────────────────────────────────────────────────────────────────────────┐
+│ ID                                   │ NAME       │ INSTANCE_CONFIGURATIONS                                                                                    │
+├──────────────────────────────────────┼────────────┼────────────────────────────────────────────────────────────────────────────────────────────────────────────┤
+│ 6981ace7-efe8-4f5c-b7c5-267b5162ce91 │ Production │ ┌────────────────┬────────┬──────────────┬─────────────────────────┬─────────┬─────────────────┬─────────┐ │
+│                                      │            │ │ CLOUD_PROVIDER │ MEMORY │ REGION       │ REGION_NAME             │ STORAGE │ TYPE            │ VERSION │ │
+│                                      │            │ ├────────────────┼────────┼──────────────┼─────────────────────────┼─────────┼─────────────────┼─────────┤ │
+│                                      │            │ │ gcp            │ 8GB    │ europe-west1 │ Belgium (europe-west1)  │ 16GB    │ enterprise-db   │ 5       │ │
+│                                      │            │ │ aws            │ 2GB    │ us-east-1    │ N. Virginia (us-east-1) │ 4GB     │ professional-db │ 5       │ │
+│                                      │            │ └────────────────┴────────┴──────────────┴─────────────────────────┴─────────┴─────────────────┴─────────┘ │
+└──────────────────────────────────────┴────────────┴────────────────────────────────────────────────────────────────────────────────────────────────────────────┘
 `)
 }
+
+func TestGetTenantWithTemplate(t *testing.T) {
+	tests := map[string]struct {
+		template         string
+		expectedResponse string
+	}{
+		"len of item field": {
+			template:         `{{range .}}{{len .instance_configurations}}{{end}}`,
+			expectedResponse: "2",
+		},
+		"index of item field": {
+			template:         `{{range .}}{{(index .instance_configurations 0).region}}{{end}}`,
+			expectedResponse: "europe-west1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			helper := testutils.NewAuraTestHelper(t)
+			defer helper.Close()
+
+			tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+			helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, `{
+				"data": {
+					"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+					"name": "Production",
+					"instance_configurations": [
+						{
+							"cloud_provider": "gcp",
+							"memory": "8GB",
+							"region": "europe-west1",
+							"region_name": "Belgium (europe-west1)",
+							"storage": "16GB",
+							"type": "enterprise-db",
+							"version": "5"
+						},
+						{
+							"cloud_provider": "aws",
+							"memory": "2GB",
+							"region": "us-east-1",
+							"region_name": "N. Virginia (us-east-1)",
+							"storage": "4GB",
+							"type": "professional-db",
+							"version": "5"
+						}
+					]
+				}
+			}`)
+			helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+				"errors": [
+					{
+						"message": "This tenant has no instances eligible for metrics integration",
+						"reason": "tenant-incapable-of-action"
+					}
+				]
+			}`)
+
+			helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output template --template '%s'", tenantId, tt.template))
+
+			helper.AssertErr("")
+			helper.AssertOut(tt.expectedResponse)
+		})
+	}
+}
+
+func TestGetTenantWithTemplateFailingToRender(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, `{
+			"data": {
+				"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+				"name": "Production",
+				"instance_configurations": []
+			}
+		}`)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusBadRequest, `{
+			"errors": [
+				{
+					"message": "This tenant has no instances eligible for metrics integration",
+					"reason": "tenant-incapable-of-action"
+				}
+			]
+		}`)
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s --output template --template '{{range .}}{{index .instance_configurations 0}}{{end}}'", tenantId))
+
+	helper.AssertOut("")
+	helper.AssertErr(`Error: cannot render template: template: output:1:13: executing "output" at <index .instance_configurations 0>: error calling index: reflect: slice index out of range`)
+	helper.AssertExitCode(clierr.ExitCodeUsage)
+}
+
+func TestGetTenantWithUnexpectedMetricsIntegrationStatus(t *testing.T) {
+	helper := testutils.NewAuraTestHelper(t)
+	defer helper.Close()
+
+	tenantId := "6981ace7-efe8-4f5c-b7c5-267b5162ce91"
+
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s", tenantId), http.StatusOK, `{
+			"data": {
+				"id": "6981ace7-efe8-4f5c-b7c5-267b5162ce91",
+				"name": "Production",
+				"instance_configurations": []
+			}
+		}`)
+	helper.NewRequestHandlerMock(fmt.Sprintf("/v1/tenants/%s/metrics-integration", tenantId), http.StatusNoContent, "")
+
+	helper.ExecuteCommand(fmt.Sprintf("tenant get %s", tenantId))
+
+	helper.AssertOut("")
+	helper.AssertErrMessage("unexpected status code 204 getting the metrics integration endpoint")
+	helper.AssertExitCode(clierr.ExitCodeUpstream)
+}
@@ -16,7 +16,7 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 		Long:  "This subcommand returns a list containing a summary of each of your Aura Tenants. To find out more about a specific Tenant, retrieve the details using the get subcommand.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
-			resBody, statusCode, err := api.MakeRequest(cfg, "/tenants", &api.RequestConfig{
+			resBody, statusCode, err := api.MakeRequest(cmd.Context(), cfg, "/tenants", &api.RequestConfig{
 				Method: http.MethodGet,
 			})
 			if err != nil {
@@ -24,7 +24,9 @@ func NewListCmd(cfg *clicfg.Config) *cobra.Command {
 			}
 
 			if statusCode == http.StatusOK {
-				output.PrintBody(cmd, cfg, resBody, []string{"id", "name"})
+				if err := output.PrintBody(cmd, cfg, resBody, output.DefaultColumns(cfg, "tenant", []string{"id", "name"})); err != nil {
+					return err
+				}
 			}
 
 			return nil
@@ -58,5 +58,5 @@ func TestListCustomerManagedKeysWithInvalidOutput(t *testing.T) {
 
 	helper.ExecuteCommand("tenant list --output invalid")
 
-	helper.AssertErr("Error: invalid output value specified: invalid")
+	helper.AssertErrMessage("invalid output value specified: invalid")
 }
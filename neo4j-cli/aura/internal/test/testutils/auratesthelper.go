@@ -2,6 +2,8 @@ package testutils
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/shlex"
 	"github.com/neo4j/cli/common/clicfg"
@@ -29,6 +32,9 @@ type AuraTestHelper struct {
 	cfg         string
 	credentials string
 	fs          afero.Fs
+	files       map[string]string
+	keepFs      bool
+	exitCode    int
 	t           *testing.T
 }
 
@@ -37,26 +43,34 @@ func (helper *AuraTestHelper) Close() {
 }
 
 func (helper *AuraTestHelper) ExecuteCommand(command string) {
+	helper.ExecuteCommandWithContext(context.Background(), command)
+}
+
+// Executes the command with a context the test can cancel, as Ctrl-C would
+func (helper *AuraTestHelper) ExecuteCommandWithContext(ctx context.Context, command string) {
 	args, err := shlex.Split(command)
 	assert.Nil(helper.t, err)
 
-	fs, err := testfs.GetTestFs(helper.cfg, helper.credentials)
-	assert.Nil(helper.t, err)
+	if !helper.keepFs || helper.fs == nil {
+		fs, err := testfs.GetTestFs(helper.cfg, helper.credentials)
+		assert.Nil(helper.t, err)
 
-	helper.fs = fs
+		helper.fs = fs
+	}
+	fs := helper.fs
 
-	cfg := clicfg.NewConfig(fs, "test")
+	for path, content := range helper.files {
+		assert.Nil(helper.t, afero.WriteFile(fs, path, []byte(content), 0600))
+	}
 
-	cfg.Aura.SetPollingConfig(5, 0)
+	cfg := clicfg.NewConfig(fs, "test")
 
 	cmd := aura.NewCmd(cfg)
 
-	cmd.SetArgs(args)
-
 	cmd.SetOut(helper.out)
 	cmd.SetErr(helper.err)
 
-	cmd.Execute()
+	helper.exitCode = aura.Execute(ctx, cmd, cfg, args)
 }
 
 func (helper *AuraTestHelper) SetConfig(cfg string) {
@@ -67,6 +81,28 @@ func (helper *AuraTestHelper) OverwriteConfig(cfg string) {
 	helper.cfg = cfg
 }
 
+// Runs the next commands on the file system left by the previous one, to observe the files it wrote
+func (helper *AuraTestHelper) KeepFs() {
+	helper.keepFs = true
+}
+
+// Adds a file to the file system the command runs with
+func (helper *AuraTestHelper) SetFile(path string, content string) {
+	if helper.files == nil {
+		helper.files = map[string]string{}
+	}
+	helper.files[path] = content
+}
+
+// Returns the content of a file left by the last command, empty when it does not exist
+func (helper *AuraTestHelper) ReadFile(path string) string {
+	data, err := afero.ReadFile(helper.fs, path)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
 func (helper *AuraTestHelper) SetConfigValue(key string, value interface{}) {
 	cfg, err := sjson.Set(helper.cfg, key, value)
 	assert.Nil(helper.t, err)
@@ -91,6 +127,44 @@ func (helper *AuraTestHelper) AssertErr(expected string) {
 	assert.Equal(helper.t, strings.TrimSpace(expected), strings.TrimSpace(string(out)))
 }
 
+// Asserts the message of the JSON error document written to stderr, after any progress events
+func (helper *AuraTestHelper) AssertErrMessage(expected string) {
+	out, err := io.ReadAll(helper.err)
+	assert.Nil(helper.t, err)
+
+	var document struct {
+		Error struct {
+			Message string
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	for decoder.More() {
+		err := decoder.Decode(&document)
+		assert.Nil(helper.t, err, "stderr is not a stream of JSON documents: %s", out)
+		if err != nil {
+			return
+		}
+	}
+
+	assert.Equal(helper.t, strings.TrimSpace(expected), document.Error.Message)
+}
+
+func (helper *AuraTestHelper) AssertErrJson(expected string) {
+	out, err := io.ReadAll(helper.err)
+	assert.Nil(helper.t, err)
+
+	formattedExpected, err := FormatJson(expected, "\t")
+	if err != nil {
+		panic(clierr.NewFatalError("invalid json in AssertErrJson: %s", err))
+	}
+
+	assert.Equal(helper.t, formattedExpected, string(out))
+}
+
+func (helper *AuraTestHelper) AssertExitCode(expected int) {
+	assert.Equal(helper.t, expected, helper.exitCode, "Unexpected exit code")
+}
+
 func (helper *AuraTestHelper) AssertOut(expected string) {
 	out, err := io.ReadAll(helper.out)
 	assert.Nil(helper.t, err)
@@ -201,14 +275,23 @@ func (helper *AuraTestHelper) NewRequestHandlerMock(path string, status int, bod
 			assert.Nil(helper.t, err)
 		}
 
-		requestCount := len(mock.Calls)
-		mock.Calls = append(mock.Calls, call{Method: req.Method, Path: req.URL.Path, Body: unmarshalledBody, QueryParams: req.URL.Query()})
+		requestCount := mock.record(call{Method: req.Method, Path: req.URL.Path, Body: unmarshalledBody, QueryParams: req.URL.Query(), Headers: req.Header})
 
 		if requestCount >= len(mock.Responses) {
 			res.WriteHeader(404)
 		} else {
 			response := mock.Responses[requestCount]
 
+			if response.delay > 0 {
+				select {
+				case <-time.After(response.delay):
+				case <-req.Context().Done():
+				}
+			}
+
+			for key, value := range response.headers {
+				res.Header().Set(key, value)
+			}
 			res.WriteHeader(response.status)
 			res.Write([]byte(response.body))
 		}
@@ -237,7 +320,14 @@ func NewAuraTestHelper(t *testing.T) AuraTestHelper {
 				"aura": {
 					"auth-url": "%s/oauth/token",
 					"base-url": "%s/v1",
-					"output": "json"
+					"output": "json",
+					"retry": {
+						"max-backoff": 0
+					},
+					"polling": {
+						"interval": 0,
+						"max-retries": 5
+					}
 					}
 				}`, server.URL, server.URL)
 	helper.credentials = `{
@@ -2,8 +2,11 @@ package testutils
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -14,17 +17,32 @@ type call struct {
 	Path        string
 	Body        map[string]interface{}
 	QueryParams url.Values
+	Headers     http.Header
 }
 
 type response struct {
-	body   string
-	status int
+	body    string
+	status  int
+	headers map[string]string
+	delay   time.Duration
 }
 
 type requestHandlerMock struct {
 	Calls     []call
 	Responses []response
 	t         *testing.T
+	// Guards Calls, as a response may still be served after the client gave up on it
+	mu sync.Mutex
+}
+
+// Records a call, returning the number of calls received before it
+func (mock *requestHandlerMock) record(c call) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.Calls = append(mock.Calls, c)
+
+	return len(mock.Calls) - 1
 }
 
 func (mock *requestHandlerMock) AddResponse(status int, body string) *requestHandlerMock {
@@ -36,13 +54,33 @@ func (mock *requestHandlerMock) AddResponse(status int, body string) *requestHan
 	return mock
 }
 
+// Sets headers on the most recently added response
+func (mock *requestHandlerMock) WithHeaders(headers map[string]string) *requestHandlerMock {
+	mock.Responses[len(mock.Responses)-1].headers = headers
+
+	return mock
+}
+
+// Delays the most recently added response, until the client gives up on the request
+func (mock *requestHandlerMock) WithDelay(delay time.Duration) *requestHandlerMock {
+	mock.Responses[len(mock.Responses)-1].delay = delay
+
+	return mock
+}
+
 func (mock *requestHandlerMock) AssertCalledTimes(times int) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
 	calls := len(mock.Calls)
 
 	assert.Equal(mock.t, times, calls, "Request handler mock not called the expected number of times")
 }
 
 func (mock *requestHandlerMock) AssertCalledWithMethod(method string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
 	methods := ""
 
 	for _, call := range mock.Calls {
@@ -57,6 +95,9 @@ func (mock *requestHandlerMock) AssertCalledWithMethod(method string) {
 }
 
 func (mock *requestHandlerMock) AssertCalledWithQueryParam(param string, value string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
 	for _, call := range mock.Calls {
 		if call.QueryParams.Has(param) && call.QueryParams.Get(param) == value {
 			return
@@ -66,7 +107,23 @@ func (mock *requestHandlerMock) AssertCalledWithQueryParam(param string, value s
 	assert.Fail(mock.t, fmt.Sprintf("Handler not called with query param:\nexpected: %s:%s", param, value))
 }
 
+func (mock *requestHandlerMock) AssertCalledWithHeader(header string, value string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	for _, call := range mock.Calls {
+		if call.Headers.Get(header) == value {
+			return
+		}
+	}
+
+	assert.Fail(mock.t, fmt.Sprintf("Handler not called with header:\nexpected: %s: %s", header, value))
+}
+
 func (mock *requestHandlerMock) AssertCalledWithBody(body string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
 	unmarshalled, err := UmarshalJson([]byte(body))
 	assert.Nil(mock.t, err)
 
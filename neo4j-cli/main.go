@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -37,5 +38,5 @@ func main() {
 	cmd := NewCmd(cfg)
 	cmd.SetOut(os.Stdout)
 	cmd.SetErr(os.Stderr)
-	cmd.Execute()
+	os.Exit(aura.Execute(context.Background(), cmd, cfg, os.Args[1:]))
 }